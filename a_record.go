@@ -1,6 +1,7 @@
 package infoblox
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 )
@@ -12,25 +13,24 @@ const (
 
 // GetARecordByRef gets A record by reference
 func (c *Client) GetARecordByRef(ref string, queryParams map[string]string) (ARecord, error) {
+	return c.GetARecordByRefWithContext(context.Background(), ref, queryParams)
+}
+
+// GetARecordByRefWithContext gets A record by reference using the supplied context
+func (c *Client) GetARecordByRefWithContext(ctx context.Context, ref string, queryParams map[string]string) (ARecord, error) {
 	var ret ARecord
-	if queryParams == nil {
-		queryParams = map[string]string{
-			"_return_fields": aRecordReturnFields,
-		}
-	} else {
-		queryParams["_return_fields"] = aRecordReturnFields
-	}
+	queryParams = returnFields(queryParams, aRecordReturnFields)
 
 	queryParamString := c.BuildQuery(queryParams)
 
-	request, err := c.CreateJSONRequest(http.MethodGet, fmt.Sprintf("%s?%s", ref, queryParamString), nil)
+	request, err := c.CreateJSONRequestWithContext(ctx, http.MethodGet, fmt.Sprintf("%s?%s", ref, queryParamString), nil)
 	if err != nil {
 		return ret, err
 	}
 
 	response := c.Call(request, &ret)
 	if response != nil {
-		return ret, fmt.Errorf(response.ErrorMessage)
+		return ret, response
 	}
 
 	return ret, nil
@@ -38,76 +38,90 @@ func (c *Client) GetARecordByRef(ref string, queryParams map[string]string) (ARe
 
 // GetARecordByQuery gets A records by query parameters
 func (c *Client) GetARecordByQuery(queryParams map[string]string) ([]ARecord, error) {
-	var ret ARecordQueryResult
-	queryParams["_return_fields"] = aRecordReturnFields
-	queryParams["_return_as_object"] = "1"
-	queryParams["_paging"] = "0"
-	queryParams["_max_results"] = "2"
+	return c.GetARecordByQueryWithContext(context.Background(), queryParams)
+}
 
-	queryParamString := c.BuildQuery(queryParams)
-	request, err := c.CreateJSONRequest(http.MethodGet, fmt.Sprintf("%s?%s", aRecordBasePath, queryParamString), nil)
-	if err != nil {
-		return nil, err
-	}
+// GetARecordByQueryWithContext gets A records by query parameters using the supplied context
+func (c *Client) GetARecordByQueryWithContext(ctx context.Context, queryParams map[string]string) ([]ARecord, error) {
+	return c.ListARecordsWithContext(ctx, queryParams)
+}
 
-	response := c.Call(request, &ret)
-	if response != nil {
-		return nil, fmt.Errorf(response.ErrorMessage)
-	}
+// ListARecords lists all A records matching query parameters following every result page
+func (c *Client) ListARecords(queryParams map[string]string) ([]ARecord, error) {
+	return c.ListARecordsWithContext(context.Background(), queryParams)
+}
 
-	return ret.Results, nil
+// ListARecordsWithContext lists all A records matching query parameters following every result page using the supplied context
+func (c *Client) ListARecordsWithContext(ctx context.Context, queryParams map[string]string) ([]ARecord, error) {
+	return listWithReturnFields[ARecord](ctx, c, aRecordBasePath, aRecordReturnFields, queryParams)
 }
 
 // CreateARecord creates A record
 func (c *Client) CreateARecord(record *ARecord) error {
+	return c.CreateARecordWithContext(context.Background(), record)
+}
+
+// CreateARecordWithContext creates A record using the supplied context
+func (c *Client) CreateARecordWithContext(ctx context.Context, record *ARecord) error {
+	record.View = c.dnsView(record.View)
 	queryParams := map[string]string{
 		"_return_fields": aRecordReturnFields,
 	}
 	queryParamString := c.BuildQuery(queryParams)
-	request, err := c.CreateJSONRequest(http.MethodPost, fmt.Sprintf("%s?%s", aRecordBasePath, queryParamString), record)
+	request, err := c.CreateJSONRequestWithContext(ctx, http.MethodPost, fmt.Sprintf("%s?%s", aRecordBasePath, queryParamString), record)
 	if err != nil {
 		return err
 	}
 
 	response := c.Call(request, &record)
 	if response != nil {
-		return fmt.Errorf(response.ErrorMessage)
+		return response
 	}
 	return nil
 }
 
 // UpdateARecord creates A record
 func (c *Client) UpdateARecord(ref string, network ARecord) (ARecord, error) {
+	return c.UpdateARecordWithContext(context.Background(), ref, network)
+}
+
+// UpdateARecordWithContext creates A record using the supplied context
+func (c *Client) UpdateARecordWithContext(ctx context.Context, ref string, network ARecord) (ARecord, error) {
 	var ret ARecord
 	queryParams := map[string]string{
 		"_return_fields": aRecordReturnFields,
 	}
 	queryParamString := c.BuildQuery(queryParams)
-	request, err := c.CreateJSONRequest(http.MethodPut, fmt.Sprintf("%s?%s", ref, queryParamString), network)
+	request, err := c.CreateJSONRequestWithContext(ctx, http.MethodPut, fmt.Sprintf("%s?%s", ref, queryParamString), network)
 	if err != nil {
 		return ret, err
 	}
 
 	response := c.Call(request, &ret)
 	if response != nil {
-		return ret, fmt.Errorf(response.ErrorMessage)
+		return ret, response
 	}
 	return ret, nil
 }
 
 // DeleteARecord creates A record
 func (c *Client) DeleteARecord(ref string) error {
-	request, err := c.CreateJSONRequest(http.MethodDelete, fmt.Sprintf("%s", ref), nil)
+	return c.DeleteARecordWithContext(context.Background(), ref)
+}
+
+// DeleteARecordWithContext creates A record using the supplied context
+func (c *Client) DeleteARecordWithContext(ctx context.Context, ref string) error {
+	request, err := c.CreateJSONRequestWithContext(ctx, http.MethodDelete, fmt.Sprintf("%s", ref), nil)
 	if err != nil {
 		return err
 	}
 
 	response := c.Call(request, nil)
 	if response != nil {
-		if response.StatusCode == 404 {
+		if IsNotFound(response) {
 			return nil
 		}
-		return err
+		return response
 	}
 	return nil
 }
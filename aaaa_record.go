@@ -0,0 +1,127 @@
+package infoblox
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+const (
+	aaaaRecordBasePath     = "record:aaaa"
+	aaaaRecordReturnFields = "ipv6addr,name,view,dns_name,disable,comment,zone,extattrs"
+)
+
+// GetAAAARecordByRef gets AAAA record by reference
+func (c *Client) GetAAAARecordByRef(ref string, queryParams map[string]string) (AAAARecord, error) {
+	return c.GetAAAARecordByRefWithContext(context.Background(), ref, queryParams)
+}
+
+// GetAAAARecordByRefWithContext gets AAAA record by reference using the supplied context
+func (c *Client) GetAAAARecordByRefWithContext(ctx context.Context, ref string, queryParams map[string]string) (AAAARecord, error) {
+	var ret AAAARecord
+	queryParams = returnFields(queryParams, aaaaRecordReturnFields)
+
+	queryParamString := c.BuildQuery(queryParams)
+
+	request, err := c.CreateJSONRequestWithContext(ctx, http.MethodGet, fmt.Sprintf("%s?%s", ref, queryParamString), nil)
+	if err != nil {
+		return ret, err
+	}
+
+	response := c.Call(request, &ret)
+	if response != nil {
+		return ret, response
+	}
+
+	return ret, nil
+}
+
+// GetAAAARecordByQuery gets AAAA records by query parameters
+func (c *Client) GetAAAARecordByQuery(queryParams map[string]string) ([]AAAARecord, error) {
+	return c.GetAAAARecordByQueryWithContext(context.Background(), queryParams)
+}
+
+// GetAAAARecordByQueryWithContext gets AAAA records by query parameters using the supplied context
+func (c *Client) GetAAAARecordByQueryWithContext(ctx context.Context, queryParams map[string]string) ([]AAAARecord, error) {
+	return c.ListAAAARecordsWithContext(ctx, queryParams)
+}
+
+// ListAAAARecords lists all AAAA records matching query parameters following every result page
+func (c *Client) ListAAAARecords(queryParams map[string]string) ([]AAAARecord, error) {
+	return c.ListAAAARecordsWithContext(context.Background(), queryParams)
+}
+
+// ListAAAARecordsWithContext lists all AAAA records matching query parameters following every result page using the supplied context
+func (c *Client) ListAAAARecordsWithContext(ctx context.Context, queryParams map[string]string) ([]AAAARecord, error) {
+	return listWithReturnFields[AAAARecord](ctx, c, aaaaRecordBasePath, aaaaRecordReturnFields, queryParams)
+}
+
+// CreateAAAARecord creates AAAA record
+func (c *Client) CreateAAAARecord(record *AAAARecord) error {
+	return c.CreateAAAARecordWithContext(context.Background(), record)
+}
+
+// CreateAAAARecordWithContext creates AAAA record using the supplied context
+func (c *Client) CreateAAAARecordWithContext(ctx context.Context, record *AAAARecord) error {
+	record.View = c.dnsView(record.View)
+	queryParams := map[string]string{
+		"_return_fields": aaaaRecordReturnFields,
+	}
+	queryParamString := c.BuildQuery(queryParams)
+	request, err := c.CreateJSONRequestWithContext(ctx, http.MethodPost, fmt.Sprintf("%s?%s", aaaaRecordBasePath, queryParamString), record)
+	if err != nil {
+		return err
+	}
+
+	response := c.Call(request, &record)
+	if response != nil {
+		return response
+	}
+	return nil
+}
+
+// UpdateAAAARecord updates AAAA record
+func (c *Client) UpdateAAAARecord(ref string, record AAAARecord) (AAAARecord, error) {
+	return c.UpdateAAAARecordWithContext(context.Background(), ref, record)
+}
+
+// UpdateAAAARecordWithContext updates AAAA record using the supplied context
+func (c *Client) UpdateAAAARecordWithContext(ctx context.Context, ref string, record AAAARecord) (AAAARecord, error) {
+	var ret AAAARecord
+	queryParams := map[string]string{
+		"_return_fields": aaaaRecordReturnFields,
+	}
+	queryParamString := c.BuildQuery(queryParams)
+	request, err := c.CreateJSONRequestWithContext(ctx, http.MethodPut, fmt.Sprintf("%s?%s", ref, queryParamString), record)
+	if err != nil {
+		return ret, err
+	}
+
+	response := c.Call(request, &ret)
+	if response != nil {
+		return ret, response
+	}
+	return ret, nil
+}
+
+// DeleteAAAARecord deletes AAAA record
+func (c *Client) DeleteAAAARecord(ref string) error {
+	return c.DeleteAAAARecordWithContext(context.Background(), ref)
+}
+
+// DeleteAAAARecordWithContext deletes AAAA record using the supplied context
+func (c *Client) DeleteAAAARecordWithContext(ctx context.Context, ref string) error {
+	request, err := c.CreateJSONRequestWithContext(ctx, http.MethodDelete, fmt.Sprintf("%s", ref), nil)
+	if err != nil {
+		return err
+	}
+
+	response := c.Call(request, nil)
+	if response != nil {
+		if IsNotFound(response) {
+			return nil
+		}
+		return response
+	}
+	return nil
+}
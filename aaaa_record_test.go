@@ -0,0 +1,123 @@
+//go:build all || unittests
+// +build all unittests
+
+package infoblox
+
+import (
+	"net"
+	"testing"
+)
+
+var (
+	aaaaRecordConfig      = testConfig()
+	aaaaRecordClient      = New(aaaaRecordConfig)
+	aaaaRecordTestNetwork = IPv6Network{
+		CIDR:        "fd00:19:0:20::/64",
+		NetworkView: "default",
+		Comment:     "AAAA testing",
+	}
+	testAAAARecord = AAAARecord{
+		Hostname: "test-api-aaaa.auslab.cisco.com",
+		View:     "default",
+		ExtensibleAttributes: newExtensibleAttribute(ExtensibleAttribute{
+			"Owner": ExtensibleAttributeValue{
+				Value: "testUser",
+			},
+		}),
+	}
+	testIPv6HostRecord = HostRecord{
+		Hostname:    "test-api-ipv6-host.auslab.cisco.com",
+		NetworkView: "default",
+		EnableDNS:   newBool(true),
+		IPv6Addrs: []IPv6Addr{
+			{
+				IPAddress: "func:nextavailableip:fd00:19:0:20::/64",
+				DUID:      "00:01:00:01:2a:3b:4c:5d:00:11:22:33:44:55",
+			},
+		},
+	}
+)
+
+func TestCreateAAAARecord(t *testing.T) {
+	err := aaaaRecordClient.CreateIPv6Network(&aaaaRecordTestNetwork)
+	if err != nil {
+		t.Errorf("Error creating IPv6 network: %s", err)
+	}
+	testAAAARecord.IPAddress = "fd00:19:0:20::10"
+	err = aaaaRecordClient.CreateAAAARecord(&testAAAARecord)
+	if err != nil {
+		t.Errorf("Error creating AAAA record: %s", err)
+	}
+}
+
+func TestGetAAAARecord(t *testing.T) {
+	record, err := aaaaRecordClient.GetAAAARecordByRef(testAAAARecord.Ref, nil)
+	if err != nil {
+		t.Errorf("Error retrieving AAAA record: %s", err)
+	}
+	if record.IPAddress != "fd00:19:0:20::10" {
+		t.Errorf("Expected AAAA record address fd00:19:0:20::10, got %s", record.IPAddress)
+	}
+	records, err := aaaaRecordClient.GetAAAARecordByQuery(map[string]string{"name": testAAAARecord.Hostname})
+	if err != nil {
+		t.Errorf("Error querying AAAA records: %s", err)
+	}
+	if len(records) != 1 {
+		t.Errorf("Expected 1 AAAA record, got %d", len(records))
+	}
+}
+
+func TestUpdateAAAARecord(t *testing.T) {
+	updates := AAAARecord{
+		Comment:   "AAAA testing updated",
+		IPAddress: "fd00:19:0:20::11",
+	}
+	record, err := aaaaRecordClient.UpdateAAAARecord(testAAAARecord.Ref, updates)
+	if err != nil {
+		t.Errorf("Error updating AAAA record: %s", err)
+	}
+	if record.IPAddress != updates.IPAddress || record.Comment != updates.Comment {
+		t.Errorf("Error updating AAAA record. Values do not match expected values")
+	}
+	testAAAARecord = record
+}
+
+func TestCreateIPv6HostRecord(t *testing.T) {
+	err := aaaaRecordClient.CreateHostRecord(&testIPv6HostRecord)
+	if err != nil {
+		t.Errorf("Error creating host record: %s", err)
+	}
+	if len(testIPv6HostRecord.IPv6Addrs) != 1 {
+		t.Fatalf("Expected 1 IPv6 address, got %d", len(testIPv6HostRecord.IPv6Addrs))
+	}
+	addr := testIPv6HostRecord.IPv6Addrs[0]
+	_, network, _ := net.ParseCIDR(aaaaRecordTestNetwork.CIDR)
+	if ip := net.ParseIP(addr.IPAddress); ip == nil || !network.Contains(ip) {
+		t.Errorf("Expected an address within %s, got %s", aaaaRecordTestNetwork.CIDR, addr.IPAddress)
+	}
+	if addr.DUID != "00:01:00:01:2a:3b:4c:5d:00:11:22:33:44:55" {
+		t.Errorf("Expected DUID to be preserved, got %s", addr.DUID)
+	}
+}
+
+func TestDeleteAAAARecord(t *testing.T) {
+	err := aaaaRecordClient.DeleteAAAARecord(testAAAARecord.Ref)
+	if err != nil {
+		t.Errorf("Error deleting AAAA record: %s", err)
+	}
+	err = aaaaRecordClient.DeleteHostRecord(testIPv6HostRecord.Ref)
+	if err != nil {
+		t.Errorf("Error deleting host record: %s", err)
+	}
+	err = aaaaRecordClient.DeleteIPv6Network(aaaaRecordTestNetwork.Ref)
+	if err != nil {
+		t.Errorf("Error deleting IPv6 network: %s", err)
+	}
+}
+
+func TestLogoutAAAARecord(t *testing.T) {
+	err := aaaaRecordClient.Logout()
+	if err != nil {
+		t.Errorf("Error logging out: %s", err)
+	}
+}
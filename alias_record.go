@@ -1,6 +1,7 @@
 package infoblox
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 )
@@ -12,25 +13,24 @@ const (
 
 // GetAliasRecordByRef gets alias record by reference
 func (c *Client) GetAliasRecordByRef(ref string, queryParams map[string]string) (AliasRecord, error) {
+	return c.GetAliasRecordByRefWithContext(context.Background(), ref, queryParams)
+}
+
+// GetAliasRecordByRefWithContext gets alias record by reference using the supplied context
+func (c *Client) GetAliasRecordByRefWithContext(ctx context.Context, ref string, queryParams map[string]string) (AliasRecord, error) {
 	var ret AliasRecord
-	if queryParams == nil {
-		queryParams = map[string]string{
-			"_return_fields": aliasRecordReturnFields,
-		}
-	} else {
-		queryParams["_return_fields"] = aliasRecordReturnFields
-	}
+	queryParams = returnFields(queryParams, aliasRecordReturnFields)
 
 	queryParamString := c.BuildQuery(queryParams)
 
-	request, err := c.CreateJSONRequest(http.MethodGet, fmt.Sprintf("%s?%s", ref, queryParamString), nil)
+	request, err := c.CreateJSONRequestWithContext(ctx, http.MethodGet, fmt.Sprintf("%s?%s", ref, queryParamString), nil)
 	if err != nil {
 		return ret, err
 	}
 
 	response := c.Call(request, &ret)
 	if response != nil {
-		return ret, fmt.Errorf(response.ErrorMessage)
+		return ret, response
 	}
 
 	return ret, nil
@@ -38,76 +38,90 @@ func (c *Client) GetAliasRecordByRef(ref string, queryParams map[string]string)
 
 // GetAliasRecordByQuery gets alias records by query parameters
 func (c *Client) GetAliasRecordByQuery(queryParams map[string]string) ([]AliasRecord, error) {
-	var ret AliasRecordQueryResult
-	queryParams["_return_fields"] = aliasRecordReturnFields
-	queryParams["_return_as_object"] = "1"
-	queryParams["_paging"] = "0"
-	queryParams["_max_results"] = "2"
+	return c.GetAliasRecordByQueryWithContext(context.Background(), queryParams)
+}
 
-	queryParamString := c.BuildQuery(queryParams)
-	request, err := c.CreateJSONRequest(http.MethodGet, fmt.Sprintf("%s?%s", aliasRecordBasePath, queryParamString), nil)
-	if err != nil {
-		return nil, err
-	}
+// GetAliasRecordByQueryWithContext gets alias records by query parameters using the supplied context
+func (c *Client) GetAliasRecordByQueryWithContext(ctx context.Context, queryParams map[string]string) ([]AliasRecord, error) {
+	return c.ListAliasRecordsWithContext(ctx, queryParams)
+}
 
-	response := c.Call(request, &ret)
-	if response != nil {
-		return nil, fmt.Errorf(response.ErrorMessage)
-	}
+// ListAliasRecords lists all alias records matching query parameters following every result page
+func (c *Client) ListAliasRecords(queryParams map[string]string) ([]AliasRecord, error) {
+	return c.ListAliasRecordsWithContext(context.Background(), queryParams)
+}
 
-	return ret.Results, nil
+// ListAliasRecordsWithContext lists all alias records matching query parameters following every result page using the supplied context
+func (c *Client) ListAliasRecordsWithContext(ctx context.Context, queryParams map[string]string) ([]AliasRecord, error) {
+	return listWithReturnFields[AliasRecord](ctx, c, aliasRecordBasePath, aliasRecordReturnFields, queryParams)
 }
 
 // CreateAliasRecord creates alias record
 func (c *Client) CreateAliasRecord(record *AliasRecord) error {
+	return c.CreateAliasRecordWithContext(context.Background(), record)
+}
+
+// CreateAliasRecordWithContext creates alias record using the supplied context
+func (c *Client) CreateAliasRecordWithContext(ctx context.Context, record *AliasRecord) error {
+	record.View = c.dnsView(record.View)
 	queryParams := map[string]string{
 		"_return_fields": aliasRecordReturnFields,
 	}
 	queryParamString := c.BuildQuery(queryParams)
-	request, err := c.CreateJSONRequest(http.MethodPost, fmt.Sprintf("%s?%s", aliasRecordBasePath, queryParamString), record)
+	request, err := c.CreateJSONRequestWithContext(ctx, http.MethodPost, fmt.Sprintf("%s?%s", aliasRecordBasePath, queryParamString), record)
 	if err != nil {
 		return err
 	}
 
 	response := c.Call(request, &record)
 	if response != nil {
-		return fmt.Errorf(response.ErrorMessage)
+		return response
 	}
 	return nil
 }
 
 // UpdateAliasRecord creates alias record
 func (c *Client) UpdateAliasRecord(ref string, network AliasRecord) (AliasRecord, error) {
+	return c.UpdateAliasRecordWithContext(context.Background(), ref, network)
+}
+
+// UpdateAliasRecordWithContext creates alias record using the supplied context
+func (c *Client) UpdateAliasRecordWithContext(ctx context.Context, ref string, network AliasRecord) (AliasRecord, error) {
 	var ret AliasRecord
 	queryParams := map[string]string{
 		"_return_fields": aliasRecordReturnFields,
 	}
 	queryParamString := c.BuildQuery(queryParams)
-	request, err := c.CreateJSONRequest(http.MethodPut, fmt.Sprintf("%s?%s", ref, queryParamString), network)
+	request, err := c.CreateJSONRequestWithContext(ctx, http.MethodPut, fmt.Sprintf("%s?%s", ref, queryParamString), network)
 	if err != nil {
 		return ret, err
 	}
 
 	response := c.Call(request, &ret)
 	if response != nil {
-		return ret, fmt.Errorf(response.ErrorMessage)
+		return ret, response
 	}
 	return ret, nil
 }
 
 // DeleteAliasRecord creates alias record
 func (c *Client) DeleteAliasRecord(ref string) error {
-	request, err := c.CreateJSONRequest(http.MethodDelete, fmt.Sprintf("%s", ref), nil)
+	return c.DeleteAliasRecordWithContext(context.Background(), ref)
+}
+
+// DeleteAliasRecordWithContext creates alias record using the supplied context
+func (c *Client) DeleteAliasRecordWithContext(ctx context.Context, ref string) error {
+	request, err := c.CreateJSONRequestWithContext(ctx, http.MethodDelete, fmt.Sprintf("%s", ref), nil)
 	if err != nil {
 		return err
 	}
 
 	response := c.Call(request, nil)
 	if response != nil {
-		if response.StatusCode == 404 {
+		if IsNotFound(response) {
 			return nil
 		}
-		return err
+		return response
 	}
 	return nil
 }
@@ -0,0 +1,372 @@
+package infoblox
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+const (
+	requestBasePath = "request"
+)
+
+// BatchOperation is a single operation of a WAPI multi-object request.
+// Values assigned to state variables by AssignState are substituted into
+// Object, Data and Args wherever BatchState placeholders appear
+type BatchOperation struct {
+	Method             string            `json:"method"`
+	Object             string            `json:"object"`
+	Data               interface{}       `json:"data,omitempty"`
+	Args               map[string]string `json:"args,omitempty"`
+	AssignState        map[string]string `json:"assign_state,omitempty"`
+	EnableSubstitution bool              `json:"enable_substitution,omitempty"`
+	Discard            bool              `json:"discard,omitempty"`
+
+	// target receives the result of creates and updates of typed objects
+	target interface{}
+}
+
+// Batch composes operations sent to the grid as a single WAPI request object.
+// The grid runs every operation in one transaction so either all of them are
+// applied or none are
+type Batch struct {
+	Operations []*BatchOperation
+}
+
+// BatchResult is the result of a batch operation that was not discarded
+type BatchResult struct {
+	Operation *BatchOperation
+	Result    json.RawMessage
+}
+
+// NewBatch creates an empty batch
+func NewBatch() *Batch {
+	return &Batch{}
+}
+
+// BatchState returns the placeholder substituted with the value of a state
+// variable assigned by an earlier operation
+func BatchState(name string) string {
+	return fmt.Sprintf("##STATE:%s:##", name)
+}
+
+// Add appends an operation to the batch
+func (b *Batch) Add(operation *BatchOperation) *BatchOperation {
+	b.Operations = append(b.Operations, operation)
+	return operation
+}
+
+// Create adds the creation of a typed object such as *HostRecord or
+// *FixedAddress.  The created object is decoded back into object when the
+// batch is executed
+func (b *Batch) Create(object interface{}) *BatchOperation {
+	return b.Add(&BatchOperation{
+		Method: http.MethodPost,
+		Data:   object,
+		target: object,
+	})
+}
+
+// Update adds the update of the object referenced by ref with the fields set
+// on a typed object.  The updated object is decoded back into object when the
+// batch is executed
+func (b *Batch) Update(ref string, object interface{}) *BatchOperation {
+	return b.Add(&BatchOperation{
+		Method: http.MethodPut,
+		Object: ref,
+		Data:   object,
+		target: object,
+	})
+}
+
+// Delete adds the deletion of the object referenced by ref.  Unlike the
+// Delete* methods a missing object fails the whole batch
+func (b *Batch) Delete(ref string) *BatchOperation {
+	return b.Add(&BatchOperation{
+		Method: http.MethodDelete,
+		Object: ref,
+	})
+}
+
+// Get adds a search of objectType.  Query parameters starting with an
+// underscore such as _return_fields are passed as arguments and the remaining
+// parameters are search fields
+func (b *Batch) Get(objectType string, queryParams map[string]string) *BatchOperation {
+	operation := &BatchOperation{
+		Method: http.MethodGet,
+		Object: objectType,
+	}
+	data := map[string]string{}
+	for k, v := range queryParams {
+		if strings.HasPrefix(k, "_") {
+			if operation.Args == nil {
+				operation.Args = map[string]string{}
+			}
+			operation.Args[k] = v
+		} else {
+			data[k] = v
+		}
+	}
+	if len(data) > 0 {
+		operation.Data = data
+	}
+	return b.Add(operation)
+}
+
+// Assign stores field of the operation result in the state variable name for
+// later operations.  Search results assign from the first object returned
+func (o *BatchOperation) Assign(name string, field string) *BatchOperation {
+	if o.AssignState == nil {
+		o.AssignState = map[string]string{}
+	}
+	o.AssignState[name] = field
+	return o
+}
+
+// Ref returns the reference of the object returned by the operation
+func (r BatchResult) Ref() string {
+	var ref string
+	if err := json.Unmarshal(r.Result, &ref); err == nil {
+		return ref
+	}
+	var objects []struct {
+		Ref string `json:"_ref"`
+	}
+	if err := json.Unmarshal(r.Result, &objects); err == nil {
+		if len(objects) > 0 {
+			return objects[0].Ref
+		}
+		return ""
+	}
+	var object struct {
+		Ref string `json:"_ref"`
+	}
+	json.Unmarshal(r.Result, &object)
+	return object.Ref
+}
+
+// Decode decodes the operation result into v
+func (r BatchResult) Decode(v interface{}) error {
+	return json.Unmarshal(r.Result, v)
+}
+
+// ExecuteBatch sends every operation of the batch as a single transactional
+// request and returns the results of the operations that were not discarded
+func (c *Client) ExecuteBatch(batch *Batch) ([]BatchResult, error) {
+	return c.ExecuteBatchWithContext(context.Background(), batch)
+}
+
+// ExecuteBatchWithContext sends every operation of the batch as a single transactional
+// request using the supplied context
+func (c *Client) ExecuteBatchWithContext(ctx context.Context, batch *Batch) ([]BatchResult, error) {
+	for i, operation := range batch.Operations {
+		if operation.target != nil {
+			objectType, returnFields, err := c.prepareBatchObject(operation.target, operation.Method == http.MethodPost)
+			if err != nil {
+				return nil, fmt.Errorf("batch operation %d: %w", i, err)
+			}
+			if c.config.FieldCheck != FieldCheckOff {
+				encoded, err := json.Marshal(operation.Data)
+				if err == nil {
+					err = c.checkFields(ctx, objectType, encoded)
+				}
+				if err != nil {
+					return nil, fmt.Errorf("batch operation %d: %w", i, err)
+				}
+			}
+			if operation.Object == "" {
+				operation.Object = objectType
+			}
+			if operation.Args == nil {
+				operation.Args = map[string]string{"_return_fields": returnFields}
+			}
+		}
+		if !operation.EnableSubstitution {
+			encoded, err := json.Marshal(operation)
+			if err != nil {
+				return nil, fmt.Errorf("batch operation %d: %w", i, err)
+			}
+			operation.EnableSubstitution = bytes.Contains(encoded, []byte("##STATE:"))
+		}
+	}
+
+	var raw []json.RawMessage
+	request, err := c.CreateJSONRequestWithContext(ctx, http.MethodPost, requestBasePath, batch.Operations)
+	if err != nil {
+		return nil, err
+	}
+	response := c.Call(request, &raw)
+	if response != nil {
+		return nil, response
+	}
+
+	var ret []BatchResult
+	for _, operation := range batch.Operations {
+		if operation.Discard {
+			continue
+		}
+		if len(ret) >= len(raw) {
+			return ret, fmt.Errorf("batch returned %d results for %d operations", len(raw), len(batch.Operations))
+		}
+		result := BatchResult{Operation: operation, Result: raw[len(ret)]}
+		if operation.target != nil && bytes.HasPrefix(bytes.TrimSpace(result.Result), []byte("{")) {
+			if err := result.Decode(operation.target); err != nil {
+				return ret, err
+			}
+		}
+		ret = append(ret, result)
+	}
+	return ret, nil
+}
+
+// prepareBatchObject applies the default views and client side validation of
+// the Create* and Update* methods to a typed object and returns its WAPI
+// object type and return fields
+func (c *Client) prepareBatchObject(object interface{}, create bool) (string, string, error) {
+	switch o := object.(type) {
+	case *ARecord:
+		if create {
+			o.View = c.dnsView(o.View)
+		}
+		return aRecordBasePath, aRecordReturnFields, nil
+	case *AAAARecord:
+		if create {
+			o.View = c.dnsView(o.View)
+		}
+		return aaaaRecordBasePath, aaaaRecordReturnFields, nil
+	case *AliasRecord:
+		if create {
+			o.View = c.dnsView(o.View)
+		}
+		return aliasRecordBasePath, aliasRecordReturnFields, nil
+	case *CAARecord:
+		if create {
+			o.View = c.dnsView(o.View)
+		}
+		return caaRecordBasePath, caaRecordReturnFields, o.Validate()
+	case *CNameRecord:
+		if create {
+			o.View = c.dnsView(o.View)
+		}
+		return cNameRecordBasePath, cNameRecordReturnFields, nil
+	case *DNAMERecord:
+		if create {
+			o.View = c.dnsView(o.View)
+		}
+		return dnameRecordBasePath, dnameRecordReturnFields, nil
+	case *HostRecord:
+		if create {
+			o.View = c.dnsView(o.View)
+			o.NetworkView = c.networkView(o.NetworkView)
+		}
+		return hostRecordBasePath, hostRecordReturnFields, nil
+	case *MXRecord:
+		if create {
+			o.View = c.dnsView(o.View)
+		}
+		return mxRecordBasePath, mxRecordReturnFields, nil
+	case *NAPTRRecord:
+		if create {
+			o.View = c.dnsView(o.View)
+		}
+		return naptrRecordBasePath, naptrRecordReturnFields, o.Validate()
+	case *NSRecord:
+		if create {
+			o.View = c.dnsView(o.View)
+		}
+		return nsRecordBasePath, nsRecordReturnFields, nil
+	case *PtrRecord:
+		if create {
+			o.View = c.dnsView(o.View)
+		}
+		return ptrRecordBasePath, ptrRecordReturnFields, nil
+	case *SRVRecord:
+		if create {
+			o.View = c.dnsView(o.View)
+		}
+		return srvRecordBasePath, srvRecordReturnFields, nil
+	case *TLSARecord:
+		if create {
+			o.View = c.dnsView(o.View)
+		}
+		return tlsaRecordBasePath, tlsaRecordReturnFields, o.Validate()
+	case *TXTRecord:
+		if create {
+			o.View = c.dnsView(o.View)
+		}
+		o.Text = QuoteTXT(o.Text)
+		return txtRecordBasePath, txtRecordReturnFields, nil
+	case *FixedAddress:
+		if create {
+			o.NetworkView = c.networkView(o.NetworkView)
+		}
+		return fixedAddressBasePath, fixedAddressReturnFields, nil
+	case *IPv6FixedAddress:
+		if create {
+			o.NetworkView = c.networkView(o.NetworkView)
+		}
+		return ipv6FixedAddressBasePath, ipv6FixedAddressReturnFields, nil
+	case *Network:
+		if create {
+			o.NetworkView = c.networkView(o.NetworkView)
+		}
+		return networkBasePath, networkReturnFields, nil
+	case *NetworkContainer:
+		if create {
+			o.NetworkView = c.networkView(o.NetworkView)
+		}
+		return containerBasePath, containerReturnFields, nil
+	case *IPv6Network:
+		if create {
+			o.NetworkView = c.networkView(o.NetworkView)
+		}
+		return ipv6NetworkBasePath, ipv6NetworkReturnFields, nil
+	case *IPv6NetworkContainer:
+		if create {
+			o.NetworkView = c.networkView(o.NetworkView)
+		}
+		return ipv6ContainerBasePath, ipv6ContainerReturnFields, nil
+	case *Range:
+		if create {
+			o.NetworkView = c.networkView(o.NetworkView)
+		}
+		o.IPAddressList = []string{}
+		return rangeBasePath, rangeReturnFields, nil
+	case *IPv6Range:
+		if create {
+			o.NetworkView = c.networkView(o.NetworkView)
+		}
+		return ipv6RangeBasePath, ipv6RangeReturnFields, nil
+	case *ZoneAuth:
+		if create {
+			o.View = c.dnsView(o.View)
+		}
+		return zoneAuthBasePath, zoneAuthReturnFields, nil
+	case *ZoneForward:
+		if create {
+			o.View = c.dnsView(o.View)
+		}
+		return zoneForwardBasePath, zoneForwardReturnFields, nil
+	case *ZoneStub:
+		if create {
+			o.View = c.dnsView(o.View)
+		}
+		return zoneStubBasePath, zoneStubReturnFields, nil
+	case *ZoneDelegated:
+		if create {
+			o.View = c.dnsView(o.View)
+		}
+		return zoneDelegatedBasePath, zoneDelegatedReturnFields, nil
+	case *DNSView:
+		if create {
+			o.NetworkView = c.networkView(o.NetworkView)
+		}
+		return dnsViewBasePath, dnsViewReturnFields, nil
+	case *NetworkView:
+		return networkViewBasePath, networkViewReturnFields, nil
+	}
+	return "", "", fmt.Errorf("unsupported batch object %T", object)
+}
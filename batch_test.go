@@ -0,0 +1,70 @@
+package infoblox
+
+import (
+	"testing"
+)
+
+func TestExecuteBatch(t *testing.T) {
+	client, server := newPaginationTestClient(t, 0)
+
+	host := HostRecord{
+		Hostname:  "app.example.com",
+		EnableDNS: newBool(false),
+		IPv4Addrs: []IPv4Addr{{IPAddress: "10.1.1.20"}},
+	}
+	alias := CNameRecord{Alias: "www.example.com", Canonical: BatchState("host")}
+	fixedAddress := FixedAddress{IPAddress: "10.1.1.21", Mac: "00:50:56:00:00:01", Comment: BatchState("host")}
+
+	batch := NewBatch()
+	batch.Create(&host).Assign("host", "name")
+	batch.Create(&alias)
+	batch.Create(&fixedAddress).Discard = true
+	batch.Get("record:host", map[string]string{"name": "app.example.com", "_return_fields": "name"})
+
+	results, err := client.ExecuteBatch(batch)
+	if err != nil {
+		t.Fatalf("Error executing batch: %s", err)
+	}
+	if len(results) != 3 {
+		t.Fatalf("Expected 3 results, got %d", len(results))
+	}
+	if host.Ref == "" || host.View != "default" {
+		t.Errorf("Expected created host record to be decoded, got %+v", host)
+	}
+	if alias.Canonical != "app.example.com" || results[1].Ref() != alias.Ref {
+		t.Errorf("Expected CNAME canonical substituted from host name, got %s", alias.Canonical)
+	}
+	if results[2].Ref() != host.Ref {
+		t.Errorf("Expected search result %s, got %s", host.Ref, results[2].Ref())
+	}
+	fixedAddresses := server.Objects("fixedaddress")
+	if len(fixedAddresses) != 1 || fixedAddresses[0]["comment"] != "app.example.com" {
+		t.Errorf("Expected fixed address with substituted comment, got %v", fixedAddresses)
+	}
+	if requests := server.Requests(); len(requests) != 1 {
+		t.Errorf("Expected a single request, got %v", requests)
+	}
+}
+
+func TestExecuteBatchRollsBack(t *testing.T) {
+	client, server := newPaginationTestClient(t, 0)
+
+	batch := NewBatch()
+	batch.Create(&ARecord{Hostname: "db.example.com", IPAddress: "10.1.1.30"})
+	batch.Delete("record:host/ZG5zLmhvc3QkLm5vbl9leGlzdGVudA:missing.example.com/default")
+
+	_, err := client.ExecuteBatch(batch)
+	if !IsNotFound(err) {
+		t.Errorf("Expected not found error, got %v", err)
+	}
+	if records := server.Objects("record:a"); len(records) != 0 {
+		t.Errorf("Expected A record creation to be rolled back, got %v", records)
+	}
+
+	batch = NewBatch()
+	batch.Create(&TLSARecord{Name: "_443._tcp.example.com", MatchedType: newInt(4)})
+	_, err = client.ExecuteBatch(batch)
+	if !IsValidationError(err) {
+		t.Errorf("Expected validation error, got %v", err)
+	}
+}
@@ -0,0 +1,152 @@
+package infoblox
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strings"
+)
+
+const (
+	caaRecordBasePath     = "record:caa"
+	caaRecordReturnFields = "name,ca_flag,ca_tag,ca_value,view,dns_name,disable,comment,zone,extattrs"
+)
+
+// GetCAARecordByRef gets CAA record by reference
+func (c *Client) GetCAARecordByRef(ref string, queryParams map[string]string) (CAARecord, error) {
+	return c.GetCAARecordByRefWithContext(context.Background(), ref, queryParams)
+}
+
+// GetCAARecordByRefWithContext gets CAA record by reference using the supplied context
+func (c *Client) GetCAARecordByRefWithContext(ctx context.Context, ref string, queryParams map[string]string) (CAARecord, error) {
+	var ret CAARecord
+	queryParams = returnFields(queryParams, caaRecordReturnFields)
+
+	queryParamString := c.BuildQuery(queryParams)
+
+	request, err := c.CreateJSONRequestWithContext(ctx, http.MethodGet, fmt.Sprintf("%s?%s", ref, queryParamString), nil)
+	if err != nil {
+		return ret, err
+	}
+
+	response := c.Call(request, &ret)
+	if response != nil {
+		return ret, response
+	}
+
+	return ret, nil
+}
+
+// GetCAARecordByQuery gets CAA records by query parameters
+func (c *Client) GetCAARecordByQuery(queryParams map[string]string) ([]CAARecord, error) {
+	return c.GetCAARecordByQueryWithContext(context.Background(), queryParams)
+}
+
+// GetCAARecordByQueryWithContext gets CAA records by query parameters using the supplied context
+func (c *Client) GetCAARecordByQueryWithContext(ctx context.Context, queryParams map[string]string) ([]CAARecord, error) {
+	return c.ListCAARecordsWithContext(ctx, queryParams)
+}
+
+// ListCAARecords lists all CAA records matching query parameters following every result page
+func (c *Client) ListCAARecords(queryParams map[string]string) ([]CAARecord, error) {
+	return c.ListCAARecordsWithContext(context.Background(), queryParams)
+}
+
+// ListCAARecordsWithContext lists all CAA records matching query parameters following every result page using the supplied context
+func (c *Client) ListCAARecordsWithContext(ctx context.Context, queryParams map[string]string) ([]CAARecord, error) {
+	return listWithReturnFields[CAARecord](ctx, c, caaRecordBasePath, caaRecordReturnFields, queryParams)
+}
+
+// CreateCAARecord creates CAA record
+func (c *Client) CreateCAARecord(record *CAARecord) error {
+	return c.CreateCAARecordWithContext(context.Background(), record)
+}
+
+// CreateCAARecordWithContext creates CAA record using the supplied context
+func (c *Client) CreateCAARecordWithContext(ctx context.Context, record *CAARecord) error {
+	record.View = c.dnsView(record.View)
+	if err := record.Validate(); err != nil {
+		return err
+	}
+	queryParams := map[string]string{
+		"_return_fields": caaRecordReturnFields,
+	}
+	queryParamString := c.BuildQuery(queryParams)
+	request, err := c.CreateJSONRequestWithContext(ctx, http.MethodPost, fmt.Sprintf("%s?%s", caaRecordBasePath, queryParamString), record)
+	if err != nil {
+		return err
+	}
+
+	response := c.Call(request, &record)
+	if response != nil {
+		return response
+	}
+	return nil
+}
+
+// UpdateCAARecord updates CAA record
+func (c *Client) UpdateCAARecord(ref string, record CAARecord) (CAARecord, error) {
+	return c.UpdateCAARecordWithContext(context.Background(), ref, record)
+}
+
+// UpdateCAARecordWithContext updates CAA record using the supplied context
+func (c *Client) UpdateCAARecordWithContext(ctx context.Context, ref string, record CAARecord) (CAARecord, error) {
+	var ret CAARecord
+	if err := record.Validate(); err != nil {
+		return ret, err
+	}
+	queryParams := map[string]string{
+		"_return_fields": caaRecordReturnFields,
+	}
+	queryParamString := c.BuildQuery(queryParams)
+	request, err := c.CreateJSONRequestWithContext(ctx, http.MethodPut, fmt.Sprintf("%s?%s", ref, queryParamString), record)
+	if err != nil {
+		return ret, err
+	}
+
+	response := c.Call(request, &ret)
+	if response != nil {
+		return ret, response
+	}
+	return ret, nil
+}
+
+// DeleteCAARecord deletes CAA record
+func (c *Client) DeleteCAARecord(ref string) error {
+	return c.DeleteCAARecordWithContext(context.Background(), ref)
+}
+
+// DeleteCAARecordWithContext deletes CAA record using the supplied context
+func (c *Client) DeleteCAARecordWithContext(ctx context.Context, ref string) error {
+	request, err := c.CreateJSONRequestWithContext(ctx, http.MethodDelete, fmt.Sprintf("%s", ref), nil)
+	if err != nil {
+		return err
+	}
+
+	response := c.Call(request, nil)
+	if response != nil {
+		if IsNotFound(response) {
+			return nil
+		}
+		return response
+	}
+	return nil
+}
+
+var caaTagPattern = regexp.MustCompile(`^[A-Za-z0-9]{1,15}$`)
+
+// Validate checks the CAA flag, tag and iodef value formats
+func (r CAARecord) Validate() error {
+	if err := validateIntRange("CAA flag", r.CAFlag, 0, 255); err != nil {
+		return err
+	}
+	if r.CATag != "" && !caaTagPattern.MatchString(r.CATag) {
+		return fmt.Errorf("%w: CAA tag %q must be 1 to 15 alphanumeric characters", ErrValidation, r.CATag)
+	}
+	if strings.EqualFold(r.CATag, "iodef") && r.CAValue != "" &&
+		!strings.HasPrefix(r.CAValue, "mailto:") && !strings.HasPrefix(r.CAValue, "http://") && !strings.HasPrefix(r.CAValue, "https://") {
+		return fmt.Errorf("%w: CAA iodef value %q must be a mailto, http or https URL", ErrValidation, r.CAValue)
+	}
+	return nil
+}
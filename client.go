@@ -2,13 +2,16 @@ package infoblox
 
 import (
 	"bytes"
-	"crypto/tls"
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
+	"log"
 	"net/http"
 	"net/url"
+	"strings"
 	"sync"
+	"time"
 )
 
 // Config - Configuration details for connecting to infoblox
@@ -19,6 +22,51 @@ type Config struct {
 	Username               string
 	Password               string
 	DisableTLSVerification bool
+	// RetryPolicy controls retries of failed requests, nil disables retries
+	RetryPolicy *RetryPolicy
+	// PageSize is the number of objects fetched per page by List methods,
+	// 0 uses the default of 1000
+	PageSize int
+	// DNSView and NetworkView are applied to created objects, searches and
+	// address queries that do not specify a view, empty values use the grid
+	// defaults
+	DNSView     string
+	NetworkView string
+	// CACertFile and CACert hold PEM encoded certificate authorities trusted
+	// in addition to the system pool when verifying the grid certificate
+	CACertFile string
+	CACert     []byte
+	// ClientCertFile and ClientKeyFile or ClientCert and ClientKey hold a PEM
+	// encoded certificate and key presented to the grid for certificate based
+	// authentication.  Basic auth is skipped when Username is empty
+	ClientCertFile string
+	ClientKeyFile  string
+	ClientCert     []byte
+	ClientKey      []byte
+	// MinTLSVersion is the minimum accepted TLS version such as
+	// tls.VersionTLS12, 0 uses the Go default
+	MinTLSVersion uint16
+	// ProxyURL is the HTTP or HTTPS proxy used to reach the grid, empty uses
+	// the HTTPS_PROXY and NO_PROXY environment variables
+	ProxyURL string
+	// Timeout limits each HTTP request including reading the response, 0
+	// disables the limit
+	Timeout time.Duration
+	// MaxIdleConns, MaxIdleConnsPerHost and IdleConnTimeout size the
+	// connection pool, 0 uses the net/http defaults
+	MaxIdleConns        int
+	MaxIdleConnsPerHost int
+	IdleConnTimeout     time.Duration
+	// HTTPClient replaces the HTTP client built from the options above and
+	// Transport replaces only its transport
+	HTTPClient *http.Client
+	Transport  http.RoundTripper
+	// FieldCheck checks the fields of created and updated objects against
+	// the object schema of the WAPI version in use
+	FieldCheck FieldCheck
+	// Logger receives warnings such as the unsupported fields reported by
+	// FieldCheckWarn, the standard logger is used when nil
+	Logger *log.Logger
 }
 
 // Client - base client for infoblox interactions
@@ -26,41 +74,151 @@ type Client struct {
 	client          *http.Client
 	config          Config
 	baseURL         string
-	cookies         []*http.Cookie
+	session         session
+	eaLock          sync.RWMutex
 	eaDefinitions   []EADefinition
 	OrchestratorEAs *ExtensibleAttribute
 	SequentialLock  sync.Mutex
+	// versionLock guards baseURL and version which are set on the first
+	// request when the WAPI version is negotiated, and negotiating which is
+	// closed once a negotiation in progress finishes
+	versionLock   sync.Mutex
+	version       string
+	negotiating   chan struct{}
+	schemaLock    sync.Mutex
+	objectSchemas map[string]ObjectSchema
+	// err holds the error building the HTTP client and is returned by every request
+	err error
 }
 
-// New - creates a new infoblox client
-func New(config Config) Client {
+// New - creates a new infoblox client.  The client is safe for concurrent
+// use and must not be copied.  Configuration errors are returned by every
+// request, use NewClient to check them up front
+func New(config Config) *Client {
+	client, _ := NewClient(config)
+	return client
+}
+
+// NewClient creates a new infoblox client applying the default port and
+// returning any configuration error or error loading the TLS certificates or
+// parsing the proxy URL.  The returned client is never nil and reports the
+// same error from every request.  When no version is configured the highest
+// version supported by the grid is negotiated on the first request
+func NewClient(config Config) (*Client, error) {
+	config = config.withDefaults()
+	err := config.Validate()
 	var client *http.Client
-	if config.DisableTLSVerification {
-		transport := &http.Transport{
-			TLSClientConfig: &tls.Config{InsecureSkipVerify: true},
-		}
-		client = &http.Client{Transport: transport}
-	} else {
-		client = http.DefaultClient
+	if err == nil {
+		client, err = newHTTPClient(config)
 	}
-	return Client{
+	ret := &Client{
 		client:  client,
 		config:  config,
-		baseURL: fmt.Sprintf("https://%s:%s/wapi/v%s", config.Host, config.Port, config.Version),
+		version: config.Version,
+		err:     err,
+	}
+	if config.Version != "" {
+		ret.baseURL = fmt.Sprintf("https://%s:%s/wapi/v%s", config.Host, config.Port, config.Version)
+	}
+	return ret, err
+}
+
+// dnsView returns view or the configured default DNS view when view is empty
+func (c *Client) dnsView(view string) string {
+	if view == "" {
+		return c.config.DNSView
+	}
+	return view
+}
+
+// networkView returns view or the configured default network view when view is empty
+func (c *Client) networkView(view string) string {
+	if view == "" {
+		return c.config.NetworkView
+	}
+	return view
+}
+
+// searchViewField returns the search field holding the view of an object
+// type, view for DNS objects and network_view for IPAM objects
+func searchViewField(objectType string) string {
+	switch objectType {
+	case networkBasePath, ipv6NetworkBasePath, containerBasePath, ipv6ContainerBasePath,
+		fixedAddressBasePath, ipv6FixedAddressBasePath, rangeBasePath, ipv6RangeBasePath:
+		return "network_view"
 	}
+	if strings.HasPrefix(objectType, "record:") || strings.HasPrefix(objectType, "zone_") {
+		return "view"
+	}
+	return ""
+}
+
+// withDefaultView returns queryParams searching the configured default view
+// of an object type when the caller does not search by view.  The caller's
+// map is copied before the view is added
+func (c *Client) withDefaultView(objectType string, queryParams map[string]string) map[string]string {
+	field := searchViewField(objectType)
+	view := c.config.DNSView
+	if field == "network_view" {
+		view = c.config.NetworkView
+	}
+	if field == "" || view == "" {
+		return queryParams
+	}
+	for key := range queryParams {
+		if strings.TrimRight(key, "!~:<>=") == field {
+			return queryParams
+		}
+	}
+	params := make(map[string]string, len(queryParams)+1)
+	for k, v := range queryParams {
+		params[k] = v
+	}
+	params[field] = view
+	return params
 }
 
 // BuildQuery creates query string
 func (c *Client) BuildQuery(params map[string]string) string {
 	q := url.Values{}
 	for k, v := range params {
-		q.Add(k, v)
+		if k != orderByParam {
+			q.Add(k, v)
+		}
 	}
 	return q.Encode()
 }
 
+// returnFields returns a copy of queryParams with _return_fields set to the
+// default fields of an object.  Fields supplied by the caller with
+// _return_fields replace the defaults and fields supplied with _return_fields+
+// are added to them.  The caller's map is left untouched so it can be reused
+func returnFields(queryParams map[string]string, defaultFields string) map[string]string {
+	params := make(map[string]string, len(queryParams)+1)
+	for k, v := range queryParams {
+		params[k] = v
+	}
+	fields := params["_return_fields"]
+	if fields == "" {
+		fields = defaultFields
+	}
+	if extra := params["_return_fields+"]; extra != "" {
+		fields = fields + "," + extra
+		delete(params, "_return_fields+")
+	}
+	params["_return_fields"] = fields
+	return params
+}
+
 // CreateJSONRequest - helper function for creating json based http requests
 func (c *Client) CreateJSONRequest(method string, path string, params interface{}) (*http.Request, error) {
+	return c.CreateJSONRequestWithContext(context.Background(), method, path, params)
+}
+
+// CreateJSONRequestWithContext - helper function for creating json based http requests
+// bound to the supplied context.  Objects created or updated are checked against
+// the object schema as configured by Config.FieldCheck
+func (c *Client) CreateJSONRequestWithContext(ctx context.Context, method string, path string, params interface{}) (*http.Request, error) {
 	var request *http.Request
 	var buf bytes.Buffer
 
@@ -68,8 +226,20 @@ func (c *Client) CreateJSONRequest(method string, path string, params interface{
 	if err != nil {
 		return request, err
 	}
-	combinedPath := fmt.Sprintf("%s/%s", c.baseURL, path)
-	request, err = http.NewRequest(method, combinedPath, &buf)
+	baseURL, err := c.wapiBaseURL(ctx)
+	if err != nil {
+		return request, err
+	}
+	if (method == http.MethodPost || method == http.MethodPut) && !strings.Contains(path, "_function") {
+		objectType := strings.SplitN(strings.SplitN(path, "?", 2)[0], "/", 2)[0]
+		if objectType != requestBasePath && objectType != "logout" {
+			if err := c.checkFields(ctx, objectType, buf.Bytes()); err != nil {
+				return request, err
+			}
+		}
+	}
+	combinedPath := fmt.Sprintf("%s/%s", baseURL, path)
+	request, err = http.NewRequestWithContext(ctx, method, combinedPath, &buf)
 	if err != nil {
 		return request, err
 	}
@@ -80,45 +250,19 @@ func (c *Client) CreateJSONRequest(method string, path string, params interface{
 	return request, nil
 }
 
-// Call - function for handling http requests
+// Call - function for handling http requests.  The request context
+// is honored so cancelling it aborts the call.  The session cookie is
+// reused between calls and renewed when it expires
 func (c *Client) Call(request *http.Request, result interface{}) *ResponseError {
-	request.SetBasicAuth(c.config.Username, c.config.Password)
-
-	// Use cookies for auth if set
-	if len(c.cookies) > 0 {
-		for i := range c.cookies {
-			request.AddCookie(c.cookies[i])
-		}
-	}
-	response, err := c.client.Do(request)
+	response, err := c.send(request)
 	if err != nil {
-		return &ResponseError{
-			StatusCode:   0,
-			Request:      fmt.Sprintf("%+v", request),
-			ResponseBody: "",
-			ErrorMessage: fmt.Sprint(err),
-		}
+		return newTransportError(request, err)
 	}
 	defer response.Body.Close()
 	if !(response.StatusCode >= 200 && response.StatusCode <= 299) {
-		var rawBodyBuffer bytes.Buffer
-		// Decode raw response, usually contains
-		// additional error details
-		body := io.TeeReader(response.Body, &rawBodyBuffer)
-		var responseBody interface{}
-		json.NewDecoder(body).Decode(&responseBody)
-		return &ResponseError{
-			StatusCode:   response.StatusCode,
-			Request:      fmt.Sprintf("%+v", request),
-			ResponseBody: fmt.Sprintf("%+v", responseBody),
-			ErrorMessage: fmt.Sprintf("Request %+v\n failed with status code %d\n response %+v", request,
-				response.StatusCode, responseBody),
-		}
-	}
-
-	// Add cookies if none exist
-	if len(c.cookies) == 0 {
-		c.cookies = response.Request.Cookies()
+		// Raw response usually contains additional error details
+		rawBody, _ := io.ReadAll(response.Body)
+		return newResponseError(request, response.StatusCode, rawBody)
 	}
 	// If no result is expected, don't attempt to decode a potentially
 	// empty response stream and avoid incurring EOF errors
@@ -127,25 +271,40 @@ func (c *Client) Call(request *http.Request, result interface{}) *ResponseError
 	}
 	err = json.NewDecoder(response.Body).Decode(&result)
 	if err != nil {
-		return &ResponseError{
-			StatusCode:   0,
-			Request:      fmt.Sprintf("%+v", request),
-			ResponseBody: "",
-			ErrorMessage: fmt.Sprint(err),
-		}
+		return newTransportError(request, err)
 	}
 	return nil
 }
 
-// Logout clears auth cookie
+// Logout ends the current session
 func (c *Client) Logout() error {
-	request, err := c.CreateJSONRequest(http.MethodPost, "logout", nil)
+	return c.LogoutWithContext(context.Background())
+}
+
+// LogoutWithContext ends the current session using the supplied context.
+// Later calls log in again
+func (c *Client) LogoutWithContext(ctx context.Context) error {
+	cookie := c.session.take()
+	if cookie == nil {
+		return nil
+	}
+	request, err := c.CreateJSONRequestWithContext(ctx, http.MethodPost, "logout", nil)
 	if err != nil {
 		return err
 	}
-	response := c.Call(request, nil)
-	if response != nil {
-		return fmt.Errorf(response.ErrorMessage)
+	request.AddCookie(cookie)
+	response, err := c.do(request)
+	if err != nil {
+		return newTransportError(request, err)
+	}
+	defer response.Body.Close()
+	// An expired session has already been invalidated by the grid
+	if response.StatusCode == http.StatusUnauthorized {
+		return nil
+	}
+	if !(response.StatusCode >= 200 && response.StatusCode <= 299) {
+		rawBody, _ := io.ReadAll(response.Body)
+		return newResponseError(request, response.StatusCode, rawBody)
 	}
 	return nil
 }
@@ -1,6 +1,7 @@
 package infoblox
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 )
@@ -12,25 +13,24 @@ const (
 
 // GetCNameRecordByRef gets cname record by reference
 func (c *Client) GetCNameRecordByRef(ref string, queryParams map[string]string) (CNameRecord, error) {
+	return c.GetCNameRecordByRefWithContext(context.Background(), ref, queryParams)
+}
+
+// GetCNameRecordByRefWithContext gets cname record by reference using the supplied context
+func (c *Client) GetCNameRecordByRefWithContext(ctx context.Context, ref string, queryParams map[string]string) (CNameRecord, error) {
 	var ret CNameRecord
-	if queryParams == nil {
-		queryParams = map[string]string{
-			"_return_fields": cNameRecordReturnFields,
-		}
-	} else {
-		queryParams["_return_fields"] = cNameRecordReturnFields
-	}
+	queryParams = returnFields(queryParams, cNameRecordReturnFields)
 
 	queryParamString := c.BuildQuery(queryParams)
 
-	request, err := c.CreateJSONRequest(http.MethodGet, fmt.Sprintf("%s?%s", ref, queryParamString), nil)
+	request, err := c.CreateJSONRequestWithContext(ctx, http.MethodGet, fmt.Sprintf("%s?%s", ref, queryParamString), nil)
 	if err != nil {
 		return ret, err
 	}
 
 	response := c.Call(request, &ret)
 	if response != nil {
-		return ret, fmt.Errorf(response.ErrorMessage)
+		return ret, response
 	}
 
 	return ret, nil
@@ -38,76 +38,90 @@ func (c *Client) GetCNameRecordByRef(ref string, queryParams map[string]string)
 
 // GetCNameRecordByQuery gets cname records by query parameters
 func (c *Client) GetCNameRecordByQuery(queryParams map[string]string) ([]CNameRecord, error) {
-	var ret CNameRecordQueryResult
-	queryParams["_return_fields"] = cNameRecordReturnFields
-	queryParams["_return_as_object"] = "1"
-	queryParams["_paging"] = "0"
-	queryParams["_max_results"] = "2"
+	return c.GetCNameRecordByQueryWithContext(context.Background(), queryParams)
+}
 
-	queryParamString := c.BuildQuery(queryParams)
-	request, err := c.CreateJSONRequest(http.MethodGet, fmt.Sprintf("%s?%s", cNameRecordBasePath, queryParamString), nil)
-	if err != nil {
-		return nil, err
-	}
+// GetCNameRecordByQueryWithContext gets cname records by query parameters using the supplied context
+func (c *Client) GetCNameRecordByQueryWithContext(ctx context.Context, queryParams map[string]string) ([]CNameRecord, error) {
+	return c.ListCNameRecordsWithContext(ctx, queryParams)
+}
 
-	response := c.Call(request, &ret)
-	if response != nil {
-		return nil, fmt.Errorf(response.ErrorMessage)
-	}
+// ListCNameRecords lists all CNAME records matching query parameters following every result page
+func (c *Client) ListCNameRecords(queryParams map[string]string) ([]CNameRecord, error) {
+	return c.ListCNameRecordsWithContext(context.Background(), queryParams)
+}
 
-	return ret.Results, nil
+// ListCNameRecordsWithContext lists all CNAME records matching query parameters following every result page using the supplied context
+func (c *Client) ListCNameRecordsWithContext(ctx context.Context, queryParams map[string]string) ([]CNameRecord, error) {
+	return listWithReturnFields[CNameRecord](ctx, c, cNameRecordBasePath, cNameRecordReturnFields, queryParams)
 }
 
 // CreateCNameRecord creates cname record
 func (c *Client) CreateCNameRecord(record *CNameRecord) error {
+	return c.CreateCNameRecordWithContext(context.Background(), record)
+}
+
+// CreateCNameRecordWithContext creates cname record using the supplied context
+func (c *Client) CreateCNameRecordWithContext(ctx context.Context, record *CNameRecord) error {
+	record.View = c.dnsView(record.View)
 	queryParams := map[string]string{
 		"_return_fields": cNameRecordReturnFields,
 	}
 	queryParamString := c.BuildQuery(queryParams)
-	request, err := c.CreateJSONRequest(http.MethodPost, fmt.Sprintf("%s?%s", cNameRecordBasePath, queryParamString), record)
+	request, err := c.CreateJSONRequestWithContext(ctx, http.MethodPost, fmt.Sprintf("%s?%s", cNameRecordBasePath, queryParamString), record)
 	if err != nil {
 		return err
 	}
 
 	response := c.Call(request, &record)
 	if response != nil {
-		return fmt.Errorf(response.ErrorMessage)
+		return response
 	}
 	return nil
 }
 
 // UpdateCNameRecord creates cname record
 func (c *Client) UpdateCNameRecord(ref string, network CNameRecord) (CNameRecord, error) {
+	return c.UpdateCNameRecordWithContext(context.Background(), ref, network)
+}
+
+// UpdateCNameRecordWithContext creates cname record using the supplied context
+func (c *Client) UpdateCNameRecordWithContext(ctx context.Context, ref string, network CNameRecord) (CNameRecord, error) {
 	var ret CNameRecord
 	queryParams := map[string]string{
 		"_return_fields": cNameRecordReturnFields,
 	}
 	queryParamString := c.BuildQuery(queryParams)
-	request, err := c.CreateJSONRequest(http.MethodPut, fmt.Sprintf("%s?%s", ref, queryParamString), network)
+	request, err := c.CreateJSONRequestWithContext(ctx, http.MethodPut, fmt.Sprintf("%s?%s", ref, queryParamString), network)
 	if err != nil {
 		return ret, err
 	}
 
 	response := c.Call(request, &ret)
 	if response != nil {
-		return ret, fmt.Errorf(response.ErrorMessage)
+		return ret, response
 	}
 	return ret, nil
 }
 
 // DeleteCNameRecord creates cname record
 func (c *Client) DeleteCNameRecord(ref string) error {
-	request, err := c.CreateJSONRequest(http.MethodDelete, fmt.Sprintf("%s", ref), nil)
+	return c.DeleteCNameRecordWithContext(context.Background(), ref)
+}
+
+// DeleteCNameRecordWithContext creates cname record using the supplied context
+func (c *Client) DeleteCNameRecordWithContext(ctx context.Context, ref string) error {
+	request, err := c.CreateJSONRequestWithContext(ctx, http.MethodDelete, fmt.Sprintf("%s", ref), nil)
 	if err != nil {
 		return err
 	}
 
 	response := c.Call(request, nil)
 	if response != nil {
-		if response.StatusCode == 404 {
+		if IsNotFound(response) {
 			return nil
 		}
-		return err
+		return response
 	}
 	return nil
 }
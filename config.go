@@ -0,0 +1,357 @@
+package infoblox
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"net"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"runtime"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	// DefaultPort is the WAPI port used when the configuration has none
+	DefaultPort = "443"
+	// DefaultProfile is the config file profile loaded when none is named.
+	// Its settings are inherited by every other profile of the file
+	DefaultProfile = "default"
+
+	envPrefix = "INFOBLOX_"
+)
+
+var versionPattern = regexp.MustCompile(`^\d+\.\d+(\.\d+)?$`)
+
+// configKeys are the settings accepted in config files.  Environment
+// variables use the upper cased key prefixed with INFOBLOX_, e.g.
+// INFOBLOX_PASSWORD_FILE
+var configKeys = []string{
+	"host",
+	"port",
+	"version",
+	"username",
+	"password",
+	"password_file",
+	"password_command",
+	"disable_tls_verification",
+	"ca_cert_file",
+	"client_cert_file",
+	"client_key_file",
+	"min_tls_version",
+	"proxy_url",
+	"timeout",
+	"page_size",
+	"dns_view",
+	"network_view",
+}
+
+// ConfigFromEnv builds a configuration from the INFOBLOX_ environment
+// variables such as INFOBLOX_HOST and INFOBLOX_PASSWORD.  When
+// INFOBLOX_CONFIG_FILE is set the INFOBLOX_PROFILE profile of that file is
+// loaded first and the environment variables override its settings
+func ConfigFromEnv() (Config, error) {
+	settings := map[string]string{}
+	if path := os.Getenv(envPrefix + "CONFIG_FILE"); path != "" {
+		var err error
+		settings, err = loadProfile(path, os.Getenv(envPrefix+"PROFILE"))
+		if err != nil {
+			return Config{}, err
+		}
+	}
+	for _, key := range configKeys {
+		if value, ok := os.LookupEnv(envPrefix + strings.ToUpper(key)); ok && value != "" {
+			settings[key] = value
+		}
+	}
+	return configFromSettings(settings)
+}
+
+// LoadConfig loads a profile of a YAML, JSON or INI config file.  The format
+// is chosen by the file extension and every top level key or INI section is a
+// profile holding settings such as host, username and password_file.  An
+// empty profile loads the default profile
+func LoadConfig(path string, profile string) (Config, error) {
+	settings, err := loadProfile(path, profile)
+	if err != nil {
+		return Config{}, err
+	}
+	return configFromSettings(settings)
+}
+
+// Validate reports configuration errors that would otherwise only surface
+// on the first request
+func (c Config) Validate() error {
+	if c.Host == "" {
+		return fmt.Errorf("%w: host is required", ErrValidation)
+	}
+	if strings.Contains(c.Host, "/") {
+		return fmt.Errorf("%w: host %s must not include a scheme or path", ErrValidation, c.Host)
+	}
+	if c.Port != "" {
+		if port, err := strconv.Atoi(c.Port); err != nil || port < 1 || port > 65535 {
+			return fmt.Errorf("%w: invalid port %s", ErrValidation, c.Port)
+		}
+	}
+	if c.Version != "" && !versionPattern.MatchString(c.Version) {
+		return fmt.Errorf("%w: invalid version %s, expected a WAPI version such as 2.11", ErrValidation, c.Version)
+	}
+	if c.Username != "" && c.Password == "" {
+		return fmt.Errorf("%w: password is required for username %s", ErrValidation, c.Username)
+	}
+	if (c.ClientCertFile == "") != (c.ClientKeyFile == "") {
+		return fmt.Errorf("%w: client_cert_file and client_key_file must be set together", ErrValidation)
+	}
+	return nil
+}
+
+// withDefaults returns the configuration with the default port applied.  An
+// empty version is left to be negotiated with the grid
+func (c Config) withDefaults() Config {
+	if c.Port == "" {
+		c.Port = DefaultPort
+	}
+	return c
+}
+
+// loadProfile reads the settings of a profile merged over the default profile
+func loadProfile(path string, profile string) (map[string]string, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("error reading config file: %w", err)
+	}
+	var profiles map[string]map[string]string
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".yaml", ".yml":
+		profiles, err = parseYAMLProfiles(data)
+	case ".json":
+		profiles, err = parseJSONProfiles(data)
+	case ".ini", ".cfg", ".conf":
+		profiles, err = parseINIProfiles(data)
+	default:
+		return nil, fmt.Errorf("unsupported config file format %s, expected .yaml, .json or .ini", path)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error parsing config file %s: %w", path, err)
+	}
+
+	if profile == "" {
+		profile = DefaultProfile
+	}
+	selected, ok := profiles[profile]
+	if !ok {
+		var names []string
+		for name := range profiles {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		return nil, fmt.Errorf("profile %s not found in %s, available profiles: %s", profile, path, strings.Join(names, ", "))
+	}
+	settings := map[string]string{}
+	for key, value := range profiles[DefaultProfile] {
+		settings[key] = value
+	}
+	for key, value := range selected {
+		settings[key] = value
+	}
+	for key := range settings {
+		if !isConfigKey(key) {
+			return nil, fmt.Errorf("unknown setting %s in profile %s of %s", key, profile, path)
+		}
+	}
+	return settings, nil
+}
+
+// parseYAMLProfiles decodes YAML profiles keeping the text of scalar values
+// so numbers such as version 2.10 are not reformatted
+func parseYAMLProfiles(data []byte) (map[string]map[string]string, error) {
+	var raw map[string]map[string]yaml.Node
+	if err := yaml.Unmarshal(data, &raw); err != nil {
+		return nil, err
+	}
+	profiles := map[string]map[string]string{}
+	for name, fields := range raw {
+		profiles[name] = map[string]string{}
+		for key, node := range fields {
+			if node.Kind == yaml.AliasNode && node.Alias != nil {
+				node = *node.Alias
+			}
+			switch {
+			case node.Kind != yaml.ScalarNode:
+				return nil, fmt.Errorf("setting %s of profile %s must be a scalar value", key, name)
+			case node.Tag == "!!null":
+				continue
+			}
+			profiles[name][key] = node.Value
+		}
+	}
+	return profiles, nil
+}
+
+// parseJSONProfiles decodes JSON profiles keeping the text of numbers so
+// values such as version 2.10 are not reformatted
+func parseJSONProfiles(data []byte) (map[string]map[string]string, error) {
+	var raw map[string]map[string]interface{}
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	if err := decoder.Decode(&raw); err != nil {
+		return nil, err
+	}
+	profiles := map[string]map[string]string{}
+	for name, fields := range raw {
+		profiles[name] = map[string]string{}
+		for key, value := range fields {
+			switch v := value.(type) {
+			case nil:
+				continue
+			case map[string]interface{}, []interface{}:
+				return nil, fmt.Errorf("setting %s of profile %s must be a scalar value", key, name)
+			default:
+				profiles[name][key] = fmt.Sprint(v)
+			}
+		}
+	}
+	return profiles, nil
+}
+
+// parseINIProfiles parses an INI file where every section is a profile.
+// Settings before the first section belong to the default profile
+func parseINIProfiles(data []byte) (map[string]map[string]string, error) {
+	profiles := map[string]map[string]string{}
+	profile := DefaultProfile
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for number := 1; scanner.Scan(); number++ {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") || strings.HasPrefix(line, ";") {
+			continue
+		}
+		if strings.HasPrefix(line, "[") && strings.HasSuffix(line, "]") {
+			profile = strings.TrimSpace(line[1 : len(line)-1])
+			if profiles[profile] == nil {
+				profiles[profile] = map[string]string{}
+			}
+			continue
+		}
+		key, value, ok := strings.Cut(line, "=")
+		if !ok {
+			return nil, fmt.Errorf("line %d: expected key = value", number)
+		}
+		value = strings.TrimSpace(value)
+		if unquoted, err := strconv.Unquote(value); err == nil {
+			value = unquoted
+		}
+		if profiles[profile] == nil {
+			profiles[profile] = map[string]string{}
+		}
+		profiles[profile][strings.TrimSpace(key)] = value
+	}
+	return profiles, scanner.Err()
+}
+
+func isConfigKey(key string) bool {
+	for _, k := range configKeys {
+		if k == key {
+			return true
+		}
+	}
+	return false
+}
+
+// configFromSettings builds a validated configuration resolving the password
+// from password_file or password_command when password is not set
+func configFromSettings(settings map[string]string) (Config, error) {
+	config := Config{
+		Host:           settings["host"],
+		Port:           settings["port"],
+		Version:        settings["version"],
+		Username:       settings["username"],
+		Password:       settings["password"],
+		CACertFile:     settings["ca_cert_file"],
+		ClientCertFile: settings["client_cert_file"],
+		ClientKeyFile:  settings["client_key_file"],
+		ProxyURL:       settings["proxy_url"],
+		DNSView:        settings["dns_view"],
+		NetworkView:    settings["network_view"],
+	}
+	var err error
+	if value := settings["disable_tls_verification"]; value != "" {
+		if config.DisableTLSVerification, err = strconv.ParseBool(value); err != nil {
+			return config, fmt.Errorf("%w: invalid disable_tls_verification %s", ErrValidation, value)
+		}
+	}
+	if value := settings["min_tls_version"]; value != "" {
+		if config.MinTLSVersion, err = parseTLSVersion(value); err != nil {
+			return config, err
+		}
+	}
+	if value := settings["timeout"]; value != "" {
+		if config.Timeout, err = time.ParseDuration(value); err != nil {
+			return config, fmt.Errorf("%w: invalid timeout %s", ErrValidation, value)
+		}
+	}
+	if value := settings["page_size"]; value != "" {
+		if config.PageSize, err = strconv.Atoi(value); err != nil {
+			return config, fmt.Errorf("%w: invalid page_size %s", ErrValidation, value)
+		}
+	}
+	if config.Password == "" {
+		if config.Password, err = resolvePassword(settings["password_file"], settings["password_command"]); err != nil {
+			return config, err
+		}
+	}
+	if host, port, err := net.SplitHostPort(config.Host); err == nil && config.Port == "" {
+		config.Host, config.Port = host, port
+	}
+	config = config.withDefaults()
+	return config, config.Validate()
+}
+
+// resolvePassword reads the password from a file or the output of a command
+func resolvePassword(file string, command string) (string, error) {
+	switch {
+	case file != "":
+		data, err := os.ReadFile(file)
+		if err != nil {
+			return "", fmt.Errorf("error reading password file: %w", err)
+		}
+		return strings.TrimRight(string(data), "\r\n"), nil
+	case command != "":
+		var cmd *exec.Cmd
+		if runtime.GOOS == "windows" {
+			cmd = exec.Command("cmd", "/C", command)
+		} else {
+			cmd = exec.Command("sh", "-c", command)
+		}
+		var stderr bytes.Buffer
+		cmd.Stderr = &stderr
+		output, err := cmd.Output()
+		if err != nil {
+			return "", fmt.Errorf("error running password command: %w: %s", err, strings.TrimSpace(stderr.String()))
+		}
+		return strings.TrimRight(string(output), "\r\n"), nil
+	}
+	return "", nil
+}
+
+func parseTLSVersion(value string) (uint16, error) {
+	switch strings.TrimPrefix(strings.ToLower(value), "tls") {
+	case "1.0", "10":
+		return tls.VersionTLS10, nil
+	case "1.1", "11":
+		return tls.VersionTLS11, nil
+	case "1.2", "12":
+		return tls.VersionTLS12, nil
+	case "1.3", "13":
+		return tls.VersionTLS13, nil
+	}
+	return 0, fmt.Errorf("%w: invalid min_tls_version %s, expected 1.0 to 1.3", ErrValidation, value)
+}
@@ -0,0 +1,191 @@
+package infoblox
+
+import (
+	"crypto/tls"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+// writeConfigFile writes a config file to a temporary directory
+func writeConfigFile(t *testing.T, name string, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), name)
+	if err := os.WriteFile(path, []byte(content), 0600); err != nil {
+		t.Fatalf("Error writing config file: %s", err)
+	}
+	return path
+}
+
+func TestConfigFromEnv(t *testing.T) {
+	passwordFile := writeConfigFile(t, "password", "s3cret\n")
+	t.Setenv("INFOBLOX_HOST", "grid.example.com")
+	t.Setenv("INFOBLOX_PORT", "")
+	t.Setenv("INFOBLOX_VERSION", "")
+	t.Setenv("INFOBLOX_USERNAME", "admin")
+	t.Setenv("INFOBLOX_PASSWORD", "")
+	t.Setenv("INFOBLOX_PASSWORD_FILE", passwordFile)
+	t.Setenv("INFOBLOX_TIMEOUT", "45s")
+	t.Setenv("INFOBLOX_DISABLE_TLS_VERIFICATION", "true")
+
+	config, err := ConfigFromEnv()
+	if err != nil {
+		t.Fatalf("Error loading config from environment: %s", err)
+	}
+	if config.Host != "grid.example.com" || config.Port != DefaultPort || config.Version != "" {
+		t.Errorf("Expected grid.example.com with default port and negotiated version, got %+v", config)
+	}
+	if config.Password != "s3cret" || config.Timeout != 45*time.Second || !config.DisableTLSVerification {
+		t.Errorf("Expected password, timeout and tls settings from environment, got %+v", config)
+	}
+
+	path := writeConfigFile(t, "infoblox.yaml", `
+lab:
+  host: lab.example.com
+  username: lab-admin
+  password: lab
+`)
+	t.Setenv("INFOBLOX_CONFIG_FILE", path)
+	t.Setenv("INFOBLOX_PROFILE", "lab")
+	t.Setenv("INFOBLOX_PASSWORD_FILE", "")
+	t.Setenv("INFOBLOX_USERNAME", "")
+	config, err = ConfigFromEnv()
+	if err != nil {
+		t.Fatalf("Error loading config from environment: %s", err)
+	}
+	if config.Host != "grid.example.com" || config.Username != "lab-admin" || config.Password != "lab" {
+		t.Errorf("Expected environment to override the lab profile, got %+v", config)
+	}
+
+	t.Setenv("INFOBLOX_CONFIG_FILE", "")
+	t.Setenv("INFOBLOX_HOST", "")
+	if _, err := ConfigFromEnv(); !IsValidationError(err) || !strings.Contains(err.Error(), "host is required") {
+		t.Errorf("Expected missing host error, got %v", err)
+	}
+}
+
+func TestLoadConfig(t *testing.T) {
+	files := map[string]string{
+		"infoblox.yaml": `
+default:
+  username: admin
+  password_command: echo from-command
+  version: "2.12"
+prod:
+  host: prod.example.com
+  min_tls_version: "1.3"
+lab:
+  host: lab.example.com:8443
+  password: lab
+  page_size: 100
+`,
+		"infoblox.json": `{
+  "default": {"username": "admin", "password_command": "echo from-command", "version": "2.12"},
+  "prod": {"host": "prod.example.com", "min_tls_version": "1.3"},
+  "lab": {"host": "lab.example.com:8443", "password": "lab", "page_size": 100}
+}`,
+		"infoblox.ini": `
+username = admin
+password_command = echo from-command
+version = 2.12
+
+[prod]
+host = prod.example.com
+min_tls_version = 1.3
+
+; lab grid
+[lab]
+host = lab.example.com:8443
+password = "lab"
+page_size = 100
+`,
+	}
+	for name, content := range files {
+		path := writeConfigFile(t, name, content)
+
+		config, err := LoadConfig(path, "prod")
+		if err != nil {
+			t.Fatalf("Error loading prod profile of %s: %s", name, err)
+		}
+		if config.Host != "prod.example.com" || config.Port != DefaultPort || config.Version != "2.12" ||
+			config.Username != "admin" || config.Password != "from-command" || config.MinTLSVersion != tls.VersionTLS13 {
+			t.Errorf("Unexpected prod profile of %s: %+v", name, config)
+		}
+
+		config, err = LoadConfig(path, "lab")
+		if err != nil {
+			t.Fatalf("Error loading lab profile of %s: %s", name, err)
+		}
+		if config.Host != "lab.example.com" || config.Port != "8443" || config.Password != "lab" || config.PageSize != 100 {
+			t.Errorf("Unexpected lab profile of %s: %+v", name, config)
+		}
+
+		if _, err := LoadConfig(path, "staging"); err == nil || !strings.Contains(err.Error(), "available profiles: default, lab, prod") {
+			t.Errorf("Expected missing profile error for %s, got %v", name, err)
+		}
+		if _, err := LoadConfig(path, ""); !IsValidationError(err) {
+			t.Errorf("Expected missing host error for default profile of %s, got %v", name, err)
+		}
+	}
+
+	path := writeConfigFile(t, "infoblox.yml", "default:\n  host: grid.example.com\n  passwd: oops\n")
+	if _, err := LoadConfig(path, ""); err == nil || !strings.Contains(err.Error(), "unknown setting passwd") {
+		t.Errorf("Expected unknown setting error, got %v", err)
+	}
+	for name, content := range map[string]string{
+		"unquoted.yaml": "default:\n  host: grid.example.com\n  version: 2.10\n  port: 8443\n",
+		"unquoted.json": `{"default": {"host": "grid.example.com", "version": 2.10, "port": 8443}}`,
+	} {
+		path = writeConfigFile(t, name, content)
+		config, err := LoadConfig(path, "")
+		if err != nil {
+			t.Fatalf("Error loading %s: %s", name, err)
+		}
+		if config.Version != "2.10" || config.Port != "8443" {
+			t.Errorf("Expected unquoted version 2.10 and port 8443 in %s, got %s and %s", name, config.Version, config.Port)
+		}
+	}
+	path = writeConfigFile(t, "infoblox.toml", "")
+	if _, err := LoadConfig(path, ""); err == nil {
+		t.Errorf("Expected unsupported format error")
+	}
+	path = writeConfigFile(t, "infoblox.ini", "[default]\nhost = grid.example.com\nusername = admin\npassword_command = exit 3\n")
+	if _, err := LoadConfig(path, ""); err == nil || !strings.Contains(err.Error(), "password command") {
+		t.Errorf("Expected password command error, got %v", err)
+	}
+}
+
+func TestConfigValidate(t *testing.T) {
+	cases := []struct {
+		config Config
+		err    string
+	}{
+		{Config{Host: "grid.example.com", Version: "2.11"}, ""},
+		{Config{Version: "2.11"}, "host is required"},
+		{Config{Host: "https://grid.example.com", Version: "2.11"}, "scheme or path"},
+		{Config{Host: "grid.example.com", Port: "https", Version: "2.11"}, "invalid port"},
+		{Config{Host: "grid.example.com"}, ""},
+		{Config{Host: "grid.example.com", Version: "v2.11"}, "invalid version"},
+		{Config{Host: "grid.example.com", Version: "2.11", Username: "admin"}, "password is required"},
+		{Config{Host: "grid.example.com", Version: "2.11", ClientCertFile: "client.pem"}, "client_key_file"},
+	}
+	for _, c := range cases {
+		err := c.config.Validate()
+		if c.err == "" && err != nil {
+			t.Errorf("Expected %+v to be valid, got %s", c.config, err)
+		}
+		if c.err != "" && (!IsValidationError(err) || !strings.Contains(err.Error(), c.err)) {
+			t.Errorf("Expected %q error for %+v, got %v", c.err, c.config, err)
+		}
+	}
+
+	client, err := NewClient(Config{Version: "2.11"})
+	if !IsValidationError(err) {
+		t.Errorf("Expected NewClient to validate the configuration, got %v", err)
+	}
+	if _, err := client.GetGridsByQuery(nil); !IsValidationError(err) {
+		t.Errorf("Expected requests to report the configuration error, got %v", err)
+	}
+}
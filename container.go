@@ -1,6 +1,7 @@
 package infoblox
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 )
@@ -12,25 +13,24 @@ const (
 
 // GetContainerByRef gets A record by reference
 func (c *Client) GetContainerByRef(ref string, queryParams map[string]string) (NetworkContainer, error) {
+	return c.GetContainerByRefWithContext(context.Background(), ref, queryParams)
+}
+
+// GetContainerByRefWithContext gets A record by reference using the supplied context
+func (c *Client) GetContainerByRefWithContext(ctx context.Context, ref string, queryParams map[string]string) (NetworkContainer, error) {
 	var ret NetworkContainer
-	if queryParams == nil {
-		queryParams = map[string]string{
-			"_return_fields": containerReturnFields,
-		}
-	} else {
-		queryParams["_return_fields"] = containerReturnFields
-	}
+	queryParams = returnFields(queryParams, containerReturnFields)
 
 	queryParamString := c.BuildQuery(queryParams)
 
-	request, err := c.CreateJSONRequest(http.MethodGet, fmt.Sprintf("%s?%s", ref, queryParamString), nil)
+	request, err := c.CreateJSONRequestWithContext(ctx, http.MethodGet, fmt.Sprintf("%s?%s", ref, queryParamString), nil)
 	if err != nil {
 		return ret, err
 	}
 
 	response := c.Call(request, &ret)
 	if response != nil {
-		return ret, fmt.Errorf(response.ErrorMessage)
+		return ret, response
 	}
 
 	return ret, nil
@@ -38,75 +38,90 @@ func (c *Client) GetContainerByRef(ref string, queryParams map[string]string) (N
 
 // GetContainerByQuery gets A records by query parameters
 func (c *Client) GetContainerByQuery(queryParams map[string]string) ([]NetworkContainer, error) {
-	var ret []NetworkContainer
-	queryParams["_return_fields"] = containerReturnFields
-	queryParams["_paging"] = "0"
-	queryParams["_max_results"] = "2"
+	return c.GetContainerByQueryWithContext(context.Background(), queryParams)
+}
 
-	queryParamString := c.BuildQuery(queryParams)
-	request, err := c.CreateJSONRequest(http.MethodGet, fmt.Sprintf("%s?%s", containerBasePath, queryParamString), nil)
-	if err != nil {
-		return nil, err
-	}
+// GetContainerByQueryWithContext gets A records by query parameters using the supplied context
+func (c *Client) GetContainerByQueryWithContext(ctx context.Context, queryParams map[string]string) ([]NetworkContainer, error) {
+	return c.ListContainersWithContext(ctx, queryParams)
+}
 
-	response := c.Call(request, &ret)
-	if response != nil {
-		return nil, fmt.Errorf(response.ErrorMessage)
-	}
+// ListContainers lists all network containers matching query parameters following every result page
+func (c *Client) ListContainers(queryParams map[string]string) ([]NetworkContainer, error) {
+	return c.ListContainersWithContext(context.Background(), queryParams)
+}
 
-	return ret, nil
+// ListContainersWithContext lists all network containers matching query parameters following every result page using the supplied context
+func (c *Client) ListContainersWithContext(ctx context.Context, queryParams map[string]string) ([]NetworkContainer, error) {
+	return listWithReturnFields[NetworkContainer](ctx, c, containerBasePath, containerReturnFields, queryParams)
 }
 
 // CreateContainer creates A record
 func (c *Client) CreateContainer(record *NetworkContainer) error {
+	return c.CreateContainerWithContext(context.Background(), record)
+}
+
+// CreateContainerWithContext creates A record using the supplied context
+func (c *Client) CreateContainerWithContext(ctx context.Context, record *NetworkContainer) error {
+	record.NetworkView = c.networkView(record.NetworkView)
 	queryParams := map[string]string{
 		"_return_fields": containerReturnFields,
 	}
 	queryParamString := c.BuildQuery(queryParams)
-	request, err := c.CreateJSONRequest(http.MethodPost, fmt.Sprintf("%s?%s", containerBasePath, queryParamString), record)
+	request, err := c.CreateJSONRequestWithContext(ctx, http.MethodPost, fmt.Sprintf("%s?%s", containerBasePath, queryParamString), record)
 	if err != nil {
 		return err
 	}
 
 	response := c.Call(request, &record)
 	if response != nil {
-		return fmt.Errorf(response.ErrorMessage)
+		return response
 	}
 	return nil
 }
 
 // UpdateContainer creates A record
 func (c *Client) UpdateContainer(ref string, network NetworkContainer) (NetworkContainer, error) {
+	return c.UpdateContainerWithContext(context.Background(), ref, network)
+}
+
+// UpdateContainerWithContext creates A record using the supplied context
+func (c *Client) UpdateContainerWithContext(ctx context.Context, ref string, network NetworkContainer) (NetworkContainer, error) {
 	var ret NetworkContainer
 	queryParams := map[string]string{
 		"_return_fields": containerReturnFields,
 	}
 	queryParamString := c.BuildQuery(queryParams)
-	request, err := c.CreateJSONRequest(http.MethodPut, fmt.Sprintf("%s?%s", ref, queryParamString), network)
+	request, err := c.CreateJSONRequestWithContext(ctx, http.MethodPut, fmt.Sprintf("%s?%s", ref, queryParamString), network)
 	if err != nil {
 		return ret, err
 	}
 
 	response := c.Call(request, &ret)
 	if response != nil {
-		return ret, fmt.Errorf(response.ErrorMessage)
+		return ret, response
 	}
 	return ret, nil
 }
 
 // DeleteContainer creates A record
 func (c *Client) DeleteContainer(ref string) error {
-	request, err := c.CreateJSONRequest(http.MethodDelete, ref, nil)
+	return c.DeleteContainerWithContext(context.Background(), ref)
+}
+
+// DeleteContainerWithContext creates A record using the supplied context
+func (c *Client) DeleteContainerWithContext(ctx context.Context, ref string) error {
+	request, err := c.CreateJSONRequestWithContext(ctx, http.MethodDelete, ref, nil)
 	if err != nil {
 		return err
 	}
 
 	response := c.Call(request, nil)
 	if response != nil {
-		if response.StatusCode == 404 {
+		if IsNotFound(response) {
 			return nil
 		}
-		return err
+		return response
 	}
 	return nil
 }
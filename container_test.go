@@ -4,19 +4,11 @@
 package infoblox
 
 import (
-	"os"
 	"testing"
 )
 
 var (
-	containerConfig = Config{
-		Host:                   os.Getenv("INFOBLOX_HOST"),
-		Port:                   os.Getenv("INFOBLOX_PORT"),
-		Username:               os.Getenv("INFOBLOX_USERNAME"),
-		Password:               os.Getenv("INFOBLOX_PASSWORD"),
-		Version:                os.Getenv("INFOBLOX_VERSION"),
-		DisableTLSVerification: true,
-	}
+	containerConfig = testConfig()
 	containerClient = New(containerConfig)
 	testContainer   = NetworkContainer{
 		CIDR:        "172.19.10.0/23",
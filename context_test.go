@@ -0,0 +1,88 @@
+package infoblox
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestCreateSequentialRangeCancelled(t *testing.T) {
+	client, server := newPaginationTestClient(t, 0)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	done := make(chan error, 1)
+	go func() {
+		done <- client.CreateSequentialRangeWithContext(ctx, &Range{}, AddressQuery{CIDR: "10.1.1.0/24", Count: 5})
+	}()
+
+	// Cancel once the addresses have been found and the 10s pre-create
+	// sleep has started
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		searched := false
+		for _, request := range server.Requests() {
+			searched = searched || strings.Contains(request, ipv4AddressBasePath)
+		}
+		if searched {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("Expected sequential addresses to be searched, got %v", server.Requests())
+		}
+		time.Sleep(time.Millisecond)
+	}
+	time.Sleep(10 * time.Millisecond)
+	cancel()
+
+	select {
+	case err := <-done:
+		if !errors.Is(err, context.Canceled) {
+			t.Errorf("Expected context.Canceled, got %v", err)
+		}
+	case <-time.After(250 * time.Millisecond):
+		t.Fatalf("Expected cancellation to abort the pre-create sleep")
+	}
+	if ranges := server.Objects("range"); len(ranges) != 0 {
+		t.Errorf("Expected no range to be created, got %v", ranges)
+	}
+}
+
+func TestGetWithContextCancelledInFlight(t *testing.T) {
+	received := make(chan struct{})
+	release := make(chan struct{})
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		close(received)
+		select {
+		case <-r.Context().Done():
+		case <-release:
+		}
+	}))
+	defer server.Close()
+	defer close(release)
+
+	client := newRetryTestClient(server, nil)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	done := make(chan error, 1)
+	go func() {
+		_, err := client.GetNetworkByRefWithContext(ctx, "network/abc:10.0.0.0/24/default", nil)
+		done <- err
+	}()
+	<-received
+	cancel()
+
+	select {
+	case err := <-done:
+		if !errors.Is(err, context.Canceled) {
+			t.Errorf("Expected context.Canceled, got %v", err)
+		}
+	case <-time.After(250 * time.Millisecond):
+		t.Fatalf("Expected cancellation to abort the in-flight request")
+	}
+}
@@ -0,0 +1,127 @@
+package infoblox
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+const (
+	dnameRecordBasePath     = "record:dname"
+	dnameRecordReturnFields = "name,target,view,dns_name,dns_target,disable,comment,zone,extattrs"
+)
+
+// GetDNAMERecordByRef gets DNAME record by reference
+func (c *Client) GetDNAMERecordByRef(ref string, queryParams map[string]string) (DNAMERecord, error) {
+	return c.GetDNAMERecordByRefWithContext(context.Background(), ref, queryParams)
+}
+
+// GetDNAMERecordByRefWithContext gets DNAME record by reference using the supplied context
+func (c *Client) GetDNAMERecordByRefWithContext(ctx context.Context, ref string, queryParams map[string]string) (DNAMERecord, error) {
+	var ret DNAMERecord
+	queryParams = returnFields(queryParams, dnameRecordReturnFields)
+
+	queryParamString := c.BuildQuery(queryParams)
+
+	request, err := c.CreateJSONRequestWithContext(ctx, http.MethodGet, fmt.Sprintf("%s?%s", ref, queryParamString), nil)
+	if err != nil {
+		return ret, err
+	}
+
+	response := c.Call(request, &ret)
+	if response != nil {
+		return ret, response
+	}
+
+	return ret, nil
+}
+
+// GetDNAMERecordByQuery gets DNAME records by query parameters
+func (c *Client) GetDNAMERecordByQuery(queryParams map[string]string) ([]DNAMERecord, error) {
+	return c.GetDNAMERecordByQueryWithContext(context.Background(), queryParams)
+}
+
+// GetDNAMERecordByQueryWithContext gets DNAME records by query parameters using the supplied context
+func (c *Client) GetDNAMERecordByQueryWithContext(ctx context.Context, queryParams map[string]string) ([]DNAMERecord, error) {
+	return c.ListDNAMERecordsWithContext(ctx, queryParams)
+}
+
+// ListDNAMERecords lists all DNAME records matching query parameters following every result page
+func (c *Client) ListDNAMERecords(queryParams map[string]string) ([]DNAMERecord, error) {
+	return c.ListDNAMERecordsWithContext(context.Background(), queryParams)
+}
+
+// ListDNAMERecordsWithContext lists all DNAME records matching query parameters following every result page using the supplied context
+func (c *Client) ListDNAMERecordsWithContext(ctx context.Context, queryParams map[string]string) ([]DNAMERecord, error) {
+	return listWithReturnFields[DNAMERecord](ctx, c, dnameRecordBasePath, dnameRecordReturnFields, queryParams)
+}
+
+// CreateDNAMERecord creates DNAME record
+func (c *Client) CreateDNAMERecord(record *DNAMERecord) error {
+	return c.CreateDNAMERecordWithContext(context.Background(), record)
+}
+
+// CreateDNAMERecordWithContext creates DNAME record using the supplied context
+func (c *Client) CreateDNAMERecordWithContext(ctx context.Context, record *DNAMERecord) error {
+	record.View = c.dnsView(record.View)
+	queryParams := map[string]string{
+		"_return_fields": dnameRecordReturnFields,
+	}
+	queryParamString := c.BuildQuery(queryParams)
+	request, err := c.CreateJSONRequestWithContext(ctx, http.MethodPost, fmt.Sprintf("%s?%s", dnameRecordBasePath, queryParamString), record)
+	if err != nil {
+		return err
+	}
+
+	response := c.Call(request, &record)
+	if response != nil {
+		return response
+	}
+	return nil
+}
+
+// UpdateDNAMERecord updates DNAME record
+func (c *Client) UpdateDNAMERecord(ref string, record DNAMERecord) (DNAMERecord, error) {
+	return c.UpdateDNAMERecordWithContext(context.Background(), ref, record)
+}
+
+// UpdateDNAMERecordWithContext updates DNAME record using the supplied context
+func (c *Client) UpdateDNAMERecordWithContext(ctx context.Context, ref string, record DNAMERecord) (DNAMERecord, error) {
+	var ret DNAMERecord
+	queryParams := map[string]string{
+		"_return_fields": dnameRecordReturnFields,
+	}
+	queryParamString := c.BuildQuery(queryParams)
+	request, err := c.CreateJSONRequestWithContext(ctx, http.MethodPut, fmt.Sprintf("%s?%s", ref, queryParamString), record)
+	if err != nil {
+		return ret, err
+	}
+
+	response := c.Call(request, &ret)
+	if response != nil {
+		return ret, response
+	}
+	return ret, nil
+}
+
+// DeleteDNAMERecord deletes DNAME record
+func (c *Client) DeleteDNAMERecord(ref string) error {
+	return c.DeleteDNAMERecordWithContext(context.Background(), ref)
+}
+
+// DeleteDNAMERecordWithContext deletes DNAME record using the supplied context
+func (c *Client) DeleteDNAMERecordWithContext(ctx context.Context, ref string) error {
+	request, err := c.CreateJSONRequestWithContext(ctx, http.MethodDelete, fmt.Sprintf("%s", ref), nil)
+	if err != nil {
+		return err
+	}
+
+	response := c.Call(request, nil)
+	if response != nil {
+		if IsNotFound(response) {
+			return nil
+		}
+		return response
+	}
+	return nil
+}
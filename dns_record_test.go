@@ -0,0 +1,256 @@
+//go:build all || unittests
+// +build all unittests
+
+package infoblox
+
+import (
+	"strings"
+	"testing"
+)
+
+var (
+	dnsRecordConfig = testConfig()
+	dnsRecordClient = New(dnsRecordConfig)
+	testMXRecord    = MXRecord{
+		Name:          "auslab.cisco.com",
+		MailExchanger: "mail.auslab.cisco.com",
+		Preference:    newInt(10),
+		View:          "default",
+		ExtensibleAttributes: newExtensibleAttribute(ExtensibleAttribute{
+			"Owner": ExtensibleAttributeValue{
+				Value: "testUser",
+			},
+		}),
+	}
+	testTXTValue  = "v=DKIM1; k=rsa; p=" + strings.Repeat("MIIBIjANBgkqhkiG9w0BAQEFAAOCAQ8A\"quoted\"", 12)
+	testTXTRecord = TXTRecord{
+		Name: "test-api-txt.auslab.cisco.com",
+		Text: testTXTValue,
+		View: "default",
+	}
+	testSRVRecord = SRVRecord{
+		Name:     "_ldap._tcp.auslab.cisco.com",
+		Target:   "ldap.auslab.cisco.com",
+		Priority: newInt(0),
+		Weight:   newInt(5),
+		Port:     newInt(389),
+		View:     "default",
+	}
+	testNSRecord = NSRecord{
+		Name:       "auslab.cisco.com",
+		NameServer: "ns1.auslab.cisco.com",
+		Addresses: []ZoneNameServer{
+			{
+				Address: "172.19.10.53",
+			},
+		},
+		View: "default",
+	}
+	testCAARecord = CAARecord{
+		Name:    "auslab.cisco.com",
+		CAFlag:  newInt(0),
+		CATag:   "issue",
+		CAValue: "letsencrypt.org",
+		View:    "default",
+	}
+	testNAPTRRecord = NAPTRRecord{
+		Name:        "auslab.cisco.com",
+		Order:       newInt(100),
+		Preference:  newInt(10),
+		Flags:       newString("S"),
+		Services:    "SIP+D2U",
+		Replacement: "_sip._udp.auslab.cisco.com",
+		View:        "default",
+	}
+	testDNAMERecord = DNAMERecord{
+		Name:   "legacy.auslab.cisco.com",
+		Target: "auslab.cisco.com",
+		View:   "default",
+	}
+	testTLSARecord = TLSARecord{
+		Name:             "_443._tcp.www.auslab.cisco.com",
+		CertificateUsage: newInt(3),
+		Selector:         newInt(1),
+		MatchedType:      newInt(1),
+		CertificateData:  strings.Repeat("0f", 32),
+		View:             "default",
+	}
+)
+
+func TestCreateMXRecord(t *testing.T) {
+	err := dnsRecordClient.CreateMXRecord(&testMXRecord)
+	if err != nil {
+		t.Errorf("Error creating MX record: %s", err)
+	}
+	if testMXRecord.Preference == nil || *testMXRecord.Preference != 10 {
+		t.Errorf("Expected MX record preference 10")
+	}
+}
+
+func TestUpdateMXRecord(t *testing.T) {
+	updates := MXRecord{
+		Preference: newInt(20),
+		ExtensibleAttributesAdd: newExtensibleAttribute(ExtensibleAttribute{
+			"Location": ExtensibleAttributeValue{
+				Value: "austin",
+			},
+		}),
+	}
+	record, err := dnsRecordClient.UpdateMXRecord(testMXRecord.Ref, updates)
+	if err != nil {
+		t.Errorf("Error updating MX record: %s", err)
+	}
+	if record.Preference == nil || *record.Preference != 20 {
+		t.Errorf("Error updating MX record. Preference does not match expected value")
+	}
+	eas := *record.ExtensibleAttributes
+	if eas["Location"].Value.(string) != "austin" || eas["Owner"].Value.(string) != "testUser" {
+		t.Errorf("Error updating MX record. EA values do not match expected values")
+	}
+	testMXRecord = record
+}
+
+func TestCreateTXTRecord(t *testing.T) {
+	err := dnsRecordClient.CreateTXTRecord(&testTXTRecord)
+	if err != nil {
+		t.Errorf("Error creating TXT record: %s", err)
+	}
+	if UnquoteTXT(testTXTRecord.Text) != testTXTValue {
+		t.Errorf("Expected TXT record text to round trip, got %s", testTXTRecord.Text)
+	}
+}
+
+func TestGetTXTRecord(t *testing.T) {
+	records, err := dnsRecordClient.GetTXTRecordByQuery(map[string]string{"name": testTXTRecord.Name})
+	if err != nil {
+		t.Errorf("Error querying TXT records: %s", err)
+	}
+	if len(records) != 1 {
+		t.Fatalf("Expected 1 TXT record, got %d", len(records))
+	}
+	if UnquoteTXT(records[0].Text) != testTXTValue {
+		t.Errorf("Expected TXT record text to round trip, got %s", records[0].Text)
+	}
+}
+
+func TestUpdateTXTRecord(t *testing.T) {
+	record, err := dnsRecordClient.UpdateTXTRecord(testTXTRecord.Ref, TXTRecord{Text: "v=spf1 -all"})
+	if err != nil {
+		t.Errorf("Error updating TXT record: %s", err)
+	}
+	if UnquoteTXT(record.Text) != "v=spf1 -all" {
+		t.Errorf("Error updating TXT record. Text does not match expected value")
+	}
+}
+
+func TestCreateSRVRecord(t *testing.T) {
+	err := dnsRecordClient.CreateSRVRecord(&testSRVRecord)
+	if err != nil {
+		t.Errorf("Error creating SRV record: %s", err)
+	}
+	record, err := dnsRecordClient.GetSRVRecordByRef(testSRVRecord.Ref, nil)
+	if err != nil {
+		t.Errorf("Error retrieving SRV record: %s", err)
+	}
+	if record.Priority == nil || *record.Priority != 0 || *record.Weight != 5 || *record.Port != 389 {
+		t.Errorf("Error retrieving SRV record. Priority, weight and port do not match expected values")
+	}
+}
+
+func TestCreateNSRecord(t *testing.T) {
+	err := dnsRecordClient.CreateNSRecord(&testNSRecord)
+	if err != nil {
+		t.Errorf("Error creating NS record: %s", err)
+	}
+	records, err := dnsRecordClient.ListNSRecords(map[string]string{"name": testNSRecord.Name})
+	if err != nil {
+		t.Errorf("Error listing NS records: %s", err)
+	}
+	if len(records) != 1 || records[0].NameServer != testNSRecord.NameServer {
+		t.Errorf("Expected NS record for %s", testNSRecord.NameServer)
+	}
+}
+
+func TestCreateCAARecord(t *testing.T) {
+	err := dnsRecordClient.CreateCAARecord(&testCAARecord)
+	if err != nil {
+		t.Errorf("Error creating CAA record: %s", err)
+	}
+	record, err := dnsRecordClient.UpdateCAARecord(testCAARecord.Ref, CAARecord{CAFlag: newInt(128)})
+	if err != nil {
+		t.Errorf("Error updating CAA record: %s", err)
+	}
+	if record.CAFlag == nil || *record.CAFlag != 128 || record.CATag != "issue" {
+		t.Errorf("Error updating CAA record. Values do not match expected values")
+	}
+	testCAARecord = record
+}
+
+func TestCreateNAPTRRecord(t *testing.T) {
+	err := dnsRecordClient.CreateNAPTRRecord(&testNAPTRRecord)
+	if err != nil {
+		t.Errorf("Error creating NAPTR record: %s", err)
+	}
+	records, err := dnsRecordClient.GetNAPTRRecordByQuery(map[string]string{"name": testNAPTRRecord.Name})
+	if err != nil {
+		t.Errorf("Error querying NAPTR records: %s", err)
+	}
+	if len(records) != 1 || records[0].Services != testNAPTRRecord.Services {
+		t.Errorf("Expected 1 NAPTR record with services %s", testNAPTRRecord.Services)
+	}
+}
+
+func TestCreateDNAMERecord(t *testing.T) {
+	err := dnsRecordClient.CreateDNAMERecord(&testDNAMERecord)
+	if err != nil {
+		t.Errorf("Error creating DNAME record: %s", err)
+	}
+	if testDNAMERecord.DNSTarget != testDNAMERecord.Target {
+		t.Errorf("Expected DNAME record target %s, got %s", testDNAMERecord.Target, testDNAMERecord.DNSTarget)
+	}
+}
+
+func TestCreateTLSARecord(t *testing.T) {
+	err := dnsRecordClient.CreateTLSARecord(&testTLSARecord)
+	if err != nil {
+		t.Errorf("Error creating TLSA record: %s", err)
+	}
+	_, err = dnsRecordClient.UpdateTLSARecord(testTLSARecord.Ref, TLSARecord{MatchedType: newInt(2), CertificateData: testTLSARecord.CertificateData})
+	if !IsValidationError(err) {
+		t.Errorf("Expected a validation error updating TLSA matched type with SHA-256 data, got %v", err)
+	}
+}
+
+func TestDeleteDNSRecords(t *testing.T) {
+	if err := dnsRecordClient.DeleteMXRecord(testMXRecord.Ref); err != nil {
+		t.Errorf("Error deleting MX record: %s", err)
+	}
+	if err := dnsRecordClient.DeleteTXTRecord(testTXTRecord.Ref); err != nil {
+		t.Errorf("Error deleting TXT record: %s", err)
+	}
+	if err := dnsRecordClient.DeleteSRVRecord(testSRVRecord.Ref); err != nil {
+		t.Errorf("Error deleting SRV record: %s", err)
+	}
+	if err := dnsRecordClient.DeleteNSRecord(testNSRecord.Ref); err != nil {
+		t.Errorf("Error deleting NS record: %s", err)
+	}
+	if err := dnsRecordClient.DeleteCAARecord(testCAARecord.Ref); err != nil {
+		t.Errorf("Error deleting CAA record: %s", err)
+	}
+	if err := dnsRecordClient.DeleteNAPTRRecord(testNAPTRRecord.Ref); err != nil {
+		t.Errorf("Error deleting NAPTR record: %s", err)
+	}
+	if err := dnsRecordClient.DeleteDNAMERecord(testDNAMERecord.Ref); err != nil {
+		t.Errorf("Error deleting DNAME record: %s", err)
+	}
+	if err := dnsRecordClient.DeleteTLSARecord(testTLSARecord.Ref); err != nil {
+		t.Errorf("Error deleting TLSA record: %s", err)
+	}
+}
+
+func TestLogoutDNSRecord(t *testing.T) {
+	err := dnsRecordClient.Logout()
+	if err != nil {
+		t.Errorf("Error logging out: %s", err)
+	}
+}
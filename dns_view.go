@@ -0,0 +1,127 @@
+package infoblox
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+const (
+	dnsViewBasePath     = "view"
+	dnsViewReturnFields = "name,comment,disable,network_view,is_default,recursion,extattrs"
+)
+
+// GetDNSViewByRef gets DNS view by reference
+func (c *Client) GetDNSViewByRef(ref string, queryParams map[string]string) (DNSView, error) {
+	return c.GetDNSViewByRefWithContext(context.Background(), ref, queryParams)
+}
+
+// GetDNSViewByRefWithContext gets DNS view by reference using the supplied context
+func (c *Client) GetDNSViewByRefWithContext(ctx context.Context, ref string, queryParams map[string]string) (DNSView, error) {
+	var ret DNSView
+	queryParams = returnFields(queryParams, dnsViewReturnFields)
+
+	queryParamString := c.BuildQuery(queryParams)
+
+	request, err := c.CreateJSONRequestWithContext(ctx, http.MethodGet, fmt.Sprintf("%s?%s", ref, queryParamString), nil)
+	if err != nil {
+		return ret, err
+	}
+
+	response := c.Call(request, &ret)
+	if response != nil {
+		return ret, response
+	}
+
+	return ret, nil
+}
+
+// GetDNSViewByQuery gets DNS views by query parameters
+func (c *Client) GetDNSViewByQuery(queryParams map[string]string) ([]DNSView, error) {
+	return c.GetDNSViewByQueryWithContext(context.Background(), queryParams)
+}
+
+// GetDNSViewByQueryWithContext gets DNS views by query parameters using the supplied context
+func (c *Client) GetDNSViewByQueryWithContext(ctx context.Context, queryParams map[string]string) ([]DNSView, error) {
+	return c.ListDNSViewsWithContext(ctx, queryParams)
+}
+
+// ListDNSViews lists all DNS views matching query parameters following every result page
+func (c *Client) ListDNSViews(queryParams map[string]string) ([]DNSView, error) {
+	return c.ListDNSViewsWithContext(context.Background(), queryParams)
+}
+
+// ListDNSViewsWithContext lists all DNS views matching query parameters following every result page using the supplied context
+func (c *Client) ListDNSViewsWithContext(ctx context.Context, queryParams map[string]string) ([]DNSView, error) {
+	return listWithReturnFields[DNSView](ctx, c, dnsViewBasePath, dnsViewReturnFields, queryParams)
+}
+
+// CreateDNSView creates DNS view
+func (c *Client) CreateDNSView(view *DNSView) error {
+	return c.CreateDNSViewWithContext(context.Background(), view)
+}
+
+// CreateDNSViewWithContext creates DNS view using the supplied context
+func (c *Client) CreateDNSViewWithContext(ctx context.Context, view *DNSView) error {
+	view.NetworkView = c.networkView(view.NetworkView)
+	queryParams := map[string]string{
+		"_return_fields": dnsViewReturnFields,
+	}
+	queryParamString := c.BuildQuery(queryParams)
+	request, err := c.CreateJSONRequestWithContext(ctx, http.MethodPost, fmt.Sprintf("%s?%s", dnsViewBasePath, queryParamString), view)
+	if err != nil {
+		return err
+	}
+
+	response := c.Call(request, &view)
+	if response != nil {
+		return response
+	}
+	return nil
+}
+
+// UpdateDNSView updates DNS view
+func (c *Client) UpdateDNSView(ref string, view DNSView) (DNSView, error) {
+	return c.UpdateDNSViewWithContext(context.Background(), ref, view)
+}
+
+// UpdateDNSViewWithContext updates DNS view using the supplied context
+func (c *Client) UpdateDNSViewWithContext(ctx context.Context, ref string, view DNSView) (DNSView, error) {
+	var ret DNSView
+	queryParams := map[string]string{
+		"_return_fields": dnsViewReturnFields,
+	}
+	queryParamString := c.BuildQuery(queryParams)
+	request, err := c.CreateJSONRequestWithContext(ctx, http.MethodPut, fmt.Sprintf("%s?%s", ref, queryParamString), view)
+	if err != nil {
+		return ret, err
+	}
+
+	response := c.Call(request, &ret)
+	if response != nil {
+		return ret, response
+	}
+	return ret, nil
+}
+
+// DeleteDNSView deletes DNS view
+func (c *Client) DeleteDNSView(ref string) error {
+	return c.DeleteDNSViewWithContext(context.Background(), ref)
+}
+
+// DeleteDNSViewWithContext deletes DNS view using the supplied context
+func (c *Client) DeleteDNSViewWithContext(ctx context.Context, ref string) error {
+	request, err := c.CreateJSONRequestWithContext(ctx, http.MethodDelete, fmt.Sprintf("%s", ref), nil)
+	if err != nil {
+		return err
+	}
+
+	response := c.Call(request, nil)
+	if response != nil {
+		if IsNotFound(response) {
+			return nil
+		}
+		return response
+	}
+	return nil
+}
@@ -1,6 +1,7 @@
 package infoblox
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -12,39 +13,59 @@ const (
 
 // GetEADefinitions retrieves extensible attribute definitions
 func (c *Client) GetEADefinitions(force bool) error {
+	return c.GetEADefinitionsWithContext(context.Background(), force)
+}
+
+// GetEADefinitionsWithContext retrieves extensible attribute definitions using the supplied context
+func (c *Client) GetEADefinitionsWithContext(ctx context.Context, force bool) error {
 	var ret []EADefinition
 
-	if len(c.eaDefinitions) > 0 && force != false {
+	if !force && len(c.cachedEADefinitions()) > 0 {
 		return nil
 	}
 	queryParams := map[string]string{
 		"_return_fields": "name,default_value,type,min,max,list_values",
 	}
 	queryParamString := c.BuildQuery(queryParams)
-	request, err := c.CreateJSONRequest(http.MethodGet, fmt.Sprintf("%s?%s", eaDefintionBasePath, queryParamString), nil)
+	request, err := c.CreateJSONRequestWithContext(ctx, http.MethodGet, fmt.Sprintf("%s?%s", eaDefintionBasePath, queryParamString), nil)
 	if err != nil {
 		return err
 	}
 
 	response := c.Call(request, &ret)
 	if response != nil {
-		return fmt.Errorf(response.ErrorMessage)
+		return response
 	}
 
+	c.eaLock.Lock()
 	c.eaDefinitions = ret
+	c.eaLock.Unlock()
 
 	return nil
 }
 
+func (c *Client) cachedEADefinitions() []EADefinition {
+	c.eaLock.RLock()
+	defer c.eaLock.RUnlock()
+	return c.eaDefinitions
+}
+
 // ConvertEAsToJSONString converts extensible attributes to json format
 func (c *Client) ConvertEAsToJSONString(eas ExtensibleAttribute) (map[string]string, error) {
+	return c.ConvertEAsToJSONStringWithContext(context.Background(), eas)
+}
+
+// ConvertEAsToJSONStringWithContext converts extensible attributes to json format using the supplied context
+func (c *Client) ConvertEAsToJSONStringWithContext(ctx context.Context, eas ExtensibleAttribute) (map[string]string, error) {
 	ret := make(map[string]string)
-	if len(c.eaDefinitions) == 0 {
-		c.GetEADefinitions(false)
+	definitions := c.cachedEADefinitions()
+	if len(definitions) == 0 {
+		c.GetEADefinitionsWithContext(ctx, false)
+		definitions = c.cachedEADefinitions()
 	}
 	for name, ea := range eas {
 		var target EADefinition
-		for _, def := range c.eaDefinitions {
+		for _, def := range definitions {
 			if def.Name == name {
 				target = def
 			}
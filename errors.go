@@ -0,0 +1,115 @@
+package infoblox
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// Sentinel errors matched by ResponseError through errors.Is
+var (
+	ErrNotFound         = errors.New("infoblox: object not found")
+	ErrDuplicate        = errors.New("infoblox: duplicate object")
+	ErrAuth             = errors.New("infoblox: authentication failed")
+	ErrPermissionDenied = errors.New("infoblox: permission denied")
+	ErrValidation       = errors.New("infoblox: validation failed")
+)
+
+const (
+	wapiCodeNotFound = "Client.Ibap.Data.NotFound"
+	wapiCodeConflict = "Client.Ibap.Data.Conflict"
+)
+
+func newResponseError(request *http.Request, statusCode int, rawBody []byte) *ResponseError {
+	ret := &ResponseError{
+		StatusCode:   statusCode,
+		Request:      fmt.Sprintf("%s %s", request.Method, request.URL.Redacted()),
+		ResponseBody: string(rawBody),
+	}
+	var wapiError WAPIError
+	if json.Unmarshal(rawBody, &wapiError) == nil && (wapiError.Error != "" || wapiError.Code != "") {
+		ret.WAPIError = &wapiError
+	}
+	detail := strings.TrimSpace(ret.ResponseBody)
+	if ret.WAPIError != nil {
+		detail = ret.WAPIError.Error
+		if detail == "" {
+			detail = ret.WAPIError.Text
+		}
+	}
+	ret.ErrorMessage = fmt.Sprintf("request %s failed with status code %d: %s", ret.Request, statusCode, detail)
+	return ret
+}
+
+func newTransportError(request *http.Request, err error) *ResponseError {
+	requestString := fmt.Sprintf("%s %s", request.Method, request.URL.Redacted())
+	return &ResponseError{
+		StatusCode:   0,
+		Request:      requestString,
+		ErrorMessage: fmt.Sprintf("request %s failed: %s", requestString, err),
+		Err:          err,
+	}
+}
+
+// Error implements the error interface
+func (e *ResponseError) Error() string {
+	return e.ErrorMessage
+}
+
+// Unwrap returns the underlying transport error if any
+func (e *ResponseError) Unwrap() error {
+	return e.Err
+}
+
+// Code returns the WAPI error code, e.g. Client.Ibap.Data.NotFound
+func (e *ResponseError) Code() string {
+	if e.WAPIError == nil {
+		return ""
+	}
+	return e.WAPIError.Code
+}
+
+// Is matches the response error against the package sentinel errors
+func (e *ResponseError) Is(target error) bool {
+	switch target {
+	case ErrNotFound:
+		return e.StatusCode == http.StatusNotFound || e.Code() == wapiCodeNotFound
+	case ErrDuplicate:
+		return e.Code() == wapiCodeConflict ||
+			(e.WAPIError != nil && strings.Contains(e.WAPIError.Error, "IBDataConflictError"))
+	case ErrAuth:
+		return e.StatusCode == http.StatusUnauthorized
+	case ErrPermissionDenied:
+		return e.StatusCode == http.StatusForbidden
+	case ErrValidation:
+		return e.StatusCode == http.StatusBadRequest && !e.Is(ErrNotFound) && !e.Is(ErrDuplicate)
+	}
+	return false
+}
+
+// IsNotFound reports whether err indicates a missing object
+func IsNotFound(err error) bool {
+	return errors.Is(err, ErrNotFound)
+}
+
+// IsDuplicate reports whether err indicates the object already exists
+func IsDuplicate(err error) bool {
+	return errors.Is(err, ErrDuplicate)
+}
+
+// IsAuthError reports whether err indicates invalid credentials
+func IsAuthError(err error) bool {
+	return errors.Is(err, ErrAuth)
+}
+
+// IsPermissionDenied reports whether err indicates insufficient permissions
+func IsPermissionDenied(err error) bool {
+	return errors.Is(err, ErrPermissionDenied)
+}
+
+// IsValidationError reports whether err indicates WAPI rejected the supplied data
+func IsValidationError(err error) bool {
+	return errors.Is(err, ErrValidation)
+}
@@ -0,0 +1,74 @@
+package infoblox
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+)
+
+func TestResponseErrorClassification(t *testing.T) {
+	request, _ := http.NewRequest(http.MethodGet, "https://grid.example.com/wapi/v2.11/network", nil)
+	tests := []struct {
+		name       string
+		statusCode int
+		body       string
+		check      func(error) bool
+	}{
+		{
+			name:       "not found",
+			statusCode: http.StatusBadRequest,
+			body:       `{"Error": "AdmConDataNotFoundError: Reference network/abc not found", "code": "Client.Ibap.Data.NotFound", "text": "Reference network/abc not found"}`,
+			check:      IsNotFound,
+		},
+		{
+			name:       "duplicate",
+			statusCode: http.StatusBadRequest,
+			body:       `{"Error": "AdmConDataError: None (IBDataConflictError: IB.Data.Conflict:The network 10.0.0.0/24 already exists.)", "code": "Client.Ibap.Data.Conflict", "text": "The network 10.0.0.0/24 already exists."}`,
+			check:      IsDuplicate,
+		},
+		{
+			name:       "auth",
+			statusCode: http.StatusUnauthorized,
+			body:       `<html>Authorization Required</html>`,
+			check:      IsAuthError,
+		},
+		{
+			name:       "permission denied",
+			statusCode: http.StatusForbidden,
+			body:       `{"Error": "AdmConProtoError: Write permission denied", "code": "Client.Ibap.Proto", "text": "Write permission denied"}`,
+			check:      IsPermissionDenied,
+		},
+		{
+			name:       "validation",
+			statusCode: http.StatusBadRequest,
+			body:       `{"Error": "AdmConProtoError: Invalid value for network", "code": "Client.Ibap.Proto", "text": "Invalid value for network"}`,
+			check:      IsValidationError,
+		},
+	}
+	for _, test := range tests {
+		var err error = newResponseError(request, test.statusCode, []byte(test.body))
+		if !test.check(err) {
+			t.Errorf("%s: error not classified correctly: %s", test.name, err)
+		}
+	}
+}
+
+func TestResponseErrorFields(t *testing.T) {
+	request, _ := http.NewRequest(http.MethodGet, "https://grid.example.com/wapi/v2.11/network", nil)
+	err := newResponseError(request, http.StatusBadRequest, []byte(`{"Error": "AdmConDataNotFoundError: Reference not found", "code": "Client.Ibap.Data.NotFound", "text": "Reference not found", "trace": "  File ..."}`))
+	if err.WAPIError == nil || err.Code() != "Client.Ibap.Data.NotFound" || err.WAPIError.Text != "Reference not found" {
+		t.Errorf("WAPI error not decoded: %+v", err)
+	}
+	if IsDuplicate(err) || IsValidationError(err) {
+		t.Errorf("Not found error misclassified: %s", err)
+	}
+}
+
+func TestTransportErrorUnwrap(t *testing.T) {
+	request, _ := http.NewRequest(http.MethodGet, "https://grid.example.com/wapi/v2.11/network", nil)
+	err := newTransportError(request, context.Canceled)
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("Expected transport error to unwrap to context.Canceled")
+	}
+}
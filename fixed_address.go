@@ -1,6 +1,7 @@
 package infoblox
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 )
@@ -12,25 +13,24 @@ const (
 
 // GetFixedAddressByRef gets fixed address by reference
 func (c *Client) GetFixedAddressByRef(ref string, queryParams map[string]string) (FixedAddress, error) {
+	return c.GetFixedAddressByRefWithContext(context.Background(), ref, queryParams)
+}
+
+// GetFixedAddressByRefWithContext gets fixed address by reference using the supplied context
+func (c *Client) GetFixedAddressByRefWithContext(ctx context.Context, ref string, queryParams map[string]string) (FixedAddress, error) {
 	var ret FixedAddress
 
-	if queryParams == nil {
-		queryParams = map[string]string{
-			"_return_fields": fixedAddressReturnFields,
-		}
-	} else {
-		queryParams["_return_fields"] = fixedAddressReturnFields
-	}
+	queryParams = returnFields(queryParams, fixedAddressReturnFields)
 
 	queryParamString := c.BuildQuery(queryParams)
-	request, err := c.CreateJSONRequest(http.MethodGet, fmt.Sprintf("%s?%s", ref, queryParamString), nil)
+	request, err := c.CreateJSONRequestWithContext(ctx, http.MethodGet, fmt.Sprintf("%s?%s", ref, queryParamString), nil)
 	if err != nil {
 		return ret, err
 	}
 
 	response := c.Call(request, &ret)
 	if response != nil {
-		return ret, fmt.Errorf(response.ErrorMessage)
+		return ret, response
 	}
 
 	return ret, nil
@@ -38,77 +38,90 @@ func (c *Client) GetFixedAddressByRef(ref string, queryParams map[string]string)
 
 // GetFixedAddressByQuery gets fixed address by query parameters
 func (c *Client) GetFixedAddressByQuery(queryParams map[string]string) ([]FixedAddress, error) {
-	var ret FixedAddressQueryResult
-
-	queryParams["_return_fields"] = fixedAddressReturnFields
-	queryParams["_return_as_object"] = "1"
-	queryParams["_paging"] = "0"
-	queryParams["_max_results"] = "2"
+	return c.GetFixedAddressByQueryWithContext(context.Background(), queryParams)
+}
 
-	queryParamString := c.BuildQuery(queryParams)
-	request, err := c.CreateJSONRequest(http.MethodGet, fmt.Sprintf("%s?%s", fixedAddressBasePath, queryParamString), nil)
-	if err != nil {
-		return nil, err
-	}
+// GetFixedAddressByQueryWithContext gets fixed address by query parameters using the supplied context
+func (c *Client) GetFixedAddressByQueryWithContext(ctx context.Context, queryParams map[string]string) ([]FixedAddress, error) {
+	return c.ListFixedAddressesWithContext(ctx, queryParams)
+}
 
-	response := c.Call(request, &ret)
-	if response != nil {
-		return nil, fmt.Errorf(response.ErrorMessage)
-	}
+// ListFixedAddresses lists all fixed addresses matching query parameters following every result page
+func (c *Client) ListFixedAddresses(queryParams map[string]string) ([]FixedAddress, error) {
+	return c.ListFixedAddressesWithContext(context.Background(), queryParams)
+}
 
-	return ret.Results, nil
+// ListFixedAddressesWithContext lists all fixed addresses matching query parameters following every result page using the supplied context
+func (c *Client) ListFixedAddressesWithContext(ctx context.Context, queryParams map[string]string) ([]FixedAddress, error) {
+	return listWithReturnFields[FixedAddress](ctx, c, fixedAddressBasePath, fixedAddressReturnFields, queryParams)
 }
 
 // CreateFixedAddress creates fixed address
 func (c *Client) CreateFixedAddress(fixedAddress *FixedAddress) error {
+	return c.CreateFixedAddressWithContext(context.Background(), fixedAddress)
+}
+
+// CreateFixedAddressWithContext creates fixed address using the supplied context
+func (c *Client) CreateFixedAddressWithContext(ctx context.Context, fixedAddress *FixedAddress) error {
+	fixedAddress.NetworkView = c.networkView(fixedAddress.NetworkView)
 	queryParams := map[string]string{
 		"_return_fields": fixedAddressReturnFields,
 	}
 	queryParamString := c.BuildQuery(queryParams)
-	request, err := c.CreateJSONRequest(http.MethodPost, fmt.Sprintf("%s?%s", fixedAddressBasePath, queryParamString), fixedAddress)
+	request, err := c.CreateJSONRequestWithContext(ctx, http.MethodPost, fmt.Sprintf("%s?%s", fixedAddressBasePath, queryParamString), fixedAddress)
 	if err != nil {
 		return err
 	}
 
 	response := c.Call(request, &fixedAddress)
 	if response != nil {
-		return fmt.Errorf(response.ErrorMessage)
+		return response
 	}
 	return nil
 }
 
 // UpdateFixedAddress creates fixed address
 func (c *Client) UpdateFixedAddress(ref string, fixedAddress FixedAddress) (FixedAddress, error) {
+	return c.UpdateFixedAddressWithContext(context.Background(), ref, fixedAddress)
+}
+
+// UpdateFixedAddressWithContext creates fixed address using the supplied context
+func (c *Client) UpdateFixedAddressWithContext(ctx context.Context, ref string, fixedAddress FixedAddress) (FixedAddress, error) {
 	var ret FixedAddress
 	queryParams := map[string]string{
 		"_return_fields": fixedAddressReturnFields,
 	}
 	queryParamString := c.BuildQuery(queryParams)
-	request, err := c.CreateJSONRequest(http.MethodPut, fmt.Sprintf("%s?%s", ref, queryParamString), fixedAddress)
+	request, err := c.CreateJSONRequestWithContext(ctx, http.MethodPut, fmt.Sprintf("%s?%s", ref, queryParamString), fixedAddress)
 	if err != nil {
 		return ret, err
 	}
 
 	response := c.Call(request, &ret)
 	if response != nil {
-		return ret, fmt.Errorf(response.ErrorMessage)
+		return ret, response
 	}
 	return ret, nil
 }
 
 // DeleteFixedAddress creates fixed address
 func (c *Client) DeleteFixedAddress(ref string) error {
-	request, err := c.CreateJSONRequest(http.MethodDelete, fmt.Sprintf("%s", ref), nil)
+	return c.DeleteFixedAddressWithContext(context.Background(), ref)
+}
+
+// DeleteFixedAddressWithContext creates fixed address using the supplied context
+func (c *Client) DeleteFixedAddressWithContext(ctx context.Context, ref string) error {
+	request, err := c.CreateJSONRequestWithContext(ctx, http.MethodDelete, fmt.Sprintf("%s", ref), nil)
 	if err != nil {
 		return err
 	}
 
 	response := c.Call(request, nil)
 	if response != nil {
-		if response.StatusCode == 404 {
+		if IsNotFound(response) {
 			return nil
 		}
-		return err
+		return response
 	}
 	return nil
 }
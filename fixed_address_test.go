@@ -4,19 +4,11 @@
 package infoblox
 
 import (
-	"os"
 	"testing"
 )
 
 var (
-	fixedAddressConfig = Config{
-		Host:                   os.Getenv("INFOBLOX_HOST"),
-		Port:                   os.Getenv("INFOBLOX_PORT"),
-		Username:               os.Getenv("INFOBLOX_USERNAME"),
-		Password:               os.Getenv("INFOBLOX_PASSWORD"),
-		Version:                os.Getenv("INFOBLOX_VERSION"),
-		DisableTLSVerification: true,
-	}
+	fixedAddressConfig = testConfig()
 	fixedAddressClient = New(fixedAddressConfig)
 	testFixedAddress   = FixedAddress{
 		IPAddress:   "172.19.10.1",
@@ -55,7 +47,7 @@ func TestCreateFixedAddress(t *testing.T) {
 }
 
 func TestGetFixedAddress(t *testing.T) {
-	record, err := fixedAddressClient.GetFixedAddressByRef(testFixedAddress.Ref)
+	record, err := fixedAddressClient.GetFixedAddressByRef(testFixedAddress.Ref, nil)
 	if err != nil {
 		t.Errorf("Error retrieving fixed address: %s", err)
 	}
@@ -2,9 +2,12 @@ package infoblox
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
+	"fmt"
 	"log"
 	"net"
+	"time"
 )
 
 func prettyPrint(object interface{}) {
@@ -20,13 +23,49 @@ func newBool(b bool) *bool {
 	return &b
 }
 
+func newInt(i int) *int {
+	return &i
+}
+
+func newString(s string) *string {
+	return &s
+}
+
+// validateIntRange checks that an optional integer field is within min and max
+func validateIntRange(field string, value *int, min int, max int) error {
+	if value != nil && (*value < min || *value > max) {
+		return fmt.Errorf("%w: %s %d must be between %d and %d", ErrValidation, field, *value, min, max)
+	}
+	return nil
+}
+
+// ipWithinRange reports whether ip falls between startAddress and endAddress
+// comparing ipv4 and ipv6 addresses as 128-bit values
 func ipWithinRange(startAddress string, endAddress string, ip string) bool {
-	trial := net.ParseIP(ip)
-	if trial.To4() == nil {
-		return false
+	return rangesOverlap(startAddress, endAddress, ip, ip)
+}
+
+// rangesOverlap reports whether two address ranges of the same family overlap
+func rangesOverlap(startA string, endA string, startB string, endB string) bool {
+	var bounds [4]net.IP
+	for i, address := range []string{startA, endA, startB, endB} {
+		bounds[i] = net.ParseIP(address)
+		if bounds[i] == nil || (bounds[i].To4() == nil) != (bounds[0].To4() == nil) {
+			return false
+		}
+		bounds[i] = bounds[i].To16()
 	}
-	if bytes.Compare(trial, net.ParseIP(startAddress)) >= 0 && bytes.Compare(trial, net.ParseIP(endAddress)) <= 0 {
-		return true
+	return bytes.Compare(bounds[2], bounds[1]) <= 0 && bytes.Compare(bounds[0], bounds[3]) <= 0
+}
+
+// sleepWithContext pauses for the supplied duration or until the context is done
+func sleepWithContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
 	}
-	return false
 }
@@ -2,4 +2,7 @@ module github.com/techBeck03/infoblox-go-sdk
 
 go 1.18
 
-require github.com/techBeck03/go-ipmath v0.0.8
+require (
+	github.com/techBeck03/go-ipmath v0.0.8
+	gopkg.in/yaml.v3 v3.0.1
+)
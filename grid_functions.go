@@ -1,19 +1,45 @@
 package infoblox
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"net/http"
+	"time"
 )
 
 const (
-	gridBasePath       = "grid"
-	memberBasePath     = "member"
-	gridReturnFields   = "name,service_status,dns_resolver_setting"
-	memberReturnFields = "config_addr_type,host_name,platform,service_type_configuration"
+	gridBasePath                    = "grid"
+	memberBasePath                  = "member"
+	gridRestartStatusBasePath       = "grid:servicerestart:status"
+	memberRestartStatusBasePath     = "restartservicestatus"
+	restartRequestBasePath          = "grid:servicerestart:request"
+	changedObjectBasePath           = "grid:servicerestart:request:changedobject"
+	gridReturnFields                = "name,service_status,dns_resolver_setting"
+	memberReturnFields              = "config_addr_type,host_name,platform,service_type_configuration"
+	gridRestartStatusReturnFields   = "parent,grouped,failures,finished,needed_restart,no_restart,pending,pending_restart,processing,restarting,success,timeouts"
+	memberRestartStatusReturnFields = "member,dhcp_status,dns_status,reporting_status"
+	restartRequestReturnFields      = "member,service,needed,state,result,error,group,order,forced,last_updated_time"
+	changedObjectReturnFields       = "action,object_name,object_type,user_name,changed_properties,changed_time"
+	defaultRestartPollInterval      = 5 * time.Second
+)
+
+// Service restart options
+const (
+	RestartOptionForce      = "FORCE_RESTART"
+	RestartOptionIfNeeded   = "RESTART_IF_NEEDED"
+	RestartModeGrouped      = "GROUPED"
+	RestartModeSequential   = "SEQUENTIAL"
+	RestartModeSimultaneous = "SIMULTANEOUS"
 )
 
 // GetGridByRef gets grid by ref
 func (c *Client) GetGridByRef(ref string) (Grid, error) {
+	return c.GetGridByRefWithContext(context.Background(), ref)
+}
+
+// GetGridByRefWithContext gets grid by ref using the supplied context
+func (c *Client) GetGridByRefWithContext(ctx context.Context, ref string) (Grid, error) {
 	var ret Grid
 
 	queryParams := map[string]string{
@@ -21,14 +47,14 @@ func (c *Client) GetGridByRef(ref string) (Grid, error) {
 	}
 
 	queryParamString := c.BuildQuery(queryParams)
-	request, err := c.CreateJSONRequest(http.MethodGet, fmt.Sprintf("%s?%s", ref, queryParamString), nil)
+	request, err := c.CreateJSONRequestWithContext(ctx, http.MethodGet, fmt.Sprintf("%s?%s", ref, queryParamString), nil)
 	if err != nil {
 		return ret, err
 	}
 
 	response := c.Call(request, &ret)
 	if response != nil {
-		return ret, fmt.Errorf(response.ErrorMessage)
+		return ret, response
 	}
 
 	return ret, nil
@@ -36,24 +62,23 @@ func (c *Client) GetGridByRef(ref string) (Grid, error) {
 
 // GetGridsByQuery gets grid list
 func (c *Client) GetGridsByQuery(queryParams map[string]string) ([]Grid, error) {
+	return c.GetGridsByQueryWithContext(context.Background(), queryParams)
+}
+
+// GetGridsByQueryWithContext gets grid list using the supplied context
+func (c *Client) GetGridsByQueryWithContext(ctx context.Context, queryParams map[string]string) ([]Grid, error) {
 	var ret []Grid
-	if queryParams == nil {
-		queryParams = map[string]string{
-			"_return_fields": gridReturnFields,
-		}
-	} else {
-		queryParams["_return_fields"] = gridReturnFields
-	}
+	queryParams = returnFields(queryParams, gridReturnFields)
 
 	queryParamString := c.BuildQuery(queryParams)
-	request, err := c.CreateJSONRequest(http.MethodGet, fmt.Sprintf("%s?%s", gridBasePath, queryParamString), nil)
+	request, err := c.CreateJSONRequestWithContext(ctx, http.MethodGet, fmt.Sprintf("%s?%s", gridBasePath, queryParamString), nil)
 	if err != nil {
 		return ret, err
 	}
 
 	response := c.Call(request, &ret)
 	if response != nil {
-		return ret, fmt.Errorf(response.ErrorMessage)
+		return ret, response
 	}
 
 	return ret, nil
@@ -61,20 +86,25 @@ func (c *Client) GetGridsByQuery(queryParams map[string]string) ([]Grid, error)
 
 // GetGridMembersByRef gets grid member list
 func (c *Client) GetGridMembersByRef(ref string) (GridMember, error) {
+	return c.GetGridMembersByRefWithContext(context.Background(), ref)
+}
+
+// GetGridMembersByRefWithContext gets grid member list using the supplied context
+func (c *Client) GetGridMembersByRefWithContext(ctx context.Context, ref string) (GridMember, error) {
 	var ret GridMember
 
 	queryParams := map[string]string{
 		"_return_fields": memberReturnFields,
 	}
 	queryParamString := c.BuildQuery(queryParams)
-	request, err := c.CreateJSONRequest(http.MethodGet, fmt.Sprintf("%s?%s", ref, queryParamString), nil)
+	request, err := c.CreateJSONRequestWithContext(ctx, http.MethodGet, fmt.Sprintf("%s?%s", ref, queryParamString), nil)
 	if err != nil {
 		return ret, err
 	}
 
 	response := c.Call(request, &ret)
 	if response != nil {
-		return ret, fmt.Errorf(response.ErrorMessage)
+		return ret, response
 	}
 
 	return ret, nil
@@ -82,46 +112,238 @@ func (c *Client) GetGridMembersByRef(ref string) (GridMember, error) {
 
 // GetGridMembersByQuery gets grid member list
 func (c *Client) GetGridMembersByQuery(queryParams map[string]string) ([]GridMember, error) {
+	return c.GetGridMembersByQueryWithContext(context.Background(), queryParams)
+}
+
+// GetGridMembersByQueryWithContext gets grid member list using the supplied context
+func (c *Client) GetGridMembersByQueryWithContext(ctx context.Context, queryParams map[string]string) ([]GridMember, error) {
 	var ret []GridMember
 
-	if queryParams == nil {
-		queryParams = map[string]string{
-			"_return_fields": memberReturnFields,
-		}
-	} else {
-		queryParams["_return_fields"] = memberReturnFields
-	}
+	queryParams = returnFields(queryParams, memberReturnFields)
 
 	queryParamString := c.BuildQuery(queryParams)
-	request, err := c.CreateJSONRequest(http.MethodGet, fmt.Sprintf("%s?%s", memberBasePath, queryParamString), nil)
+	request, err := c.CreateJSONRequestWithContext(ctx, http.MethodGet, fmt.Sprintf("%s?%s", memberBasePath, queryParamString), nil)
 	if err != nil {
 		return ret, err
 	}
 
 	response := c.Call(request, &ret)
 	if response != nil {
-		return ret, fmt.Errorf(response.ErrorMessage)
+		return ret, response
 	}
 
 	return ret, nil
 }
 
+// GridMemberHostnames returns the host names of members for use in restart requests
+func GridMemberHostnames(members []GridMember) []string {
+	ret := []string{}
+	for _, member := range members {
+		ret = append(ret, member.Hostname)
+	}
+	return ret
+}
+
 // RestartServices restarts selected grid services
 func (c *Client) RestartServices(ref string, restartRequest GridServiceRestartRequest) error {
+	return c.RestartServicesWithContext(context.Background(), ref, restartRequest)
+}
+
+// RestartServicesWithContext restarts selected grid services using the supplied context
+func (c *Client) RestartServicesWithContext(ctx context.Context, ref string, restartRequest GridServiceRestartRequest) error {
 	queryParams := map[string]string{
 		"_function": "restartservices",
 	}
 
 	queryParamString := c.BuildQuery(queryParams)
-	request, err := c.CreateJSONRequest(http.MethodPost, fmt.Sprintf("%s?%s", ref, queryParamString), nil)
+	request, err := c.CreateJSONRequestWithContext(ctx, http.MethodPost, fmt.Sprintf("%s?%s", ref, queryParamString), restartRequest)
 	if err != nil {
 		return err
 	}
 
 	response := c.Call(request, nil)
 	if response != nil {
-		return fmt.Errorf(response.ErrorMessage)
+		return response
 	}
 
 	return nil
 }
+
+// GetRestartStatus gets grid and member service restart status
+func (c *Client) GetRestartStatus() (RestartStatus, error) {
+	return c.GetRestartStatusWithContext(context.Background())
+}
+
+// GetRestartStatusWithContext gets grid and member service restart status using the supplied context
+func (c *Client) GetRestartStatusWithContext(ctx context.Context) (RestartStatus, error) {
+	var ret RestartStatus
+	var gridStatus []GridServiceRestartStatus
+
+	queryParams := map[string]string{
+		"_return_fields": gridRestartStatusReturnFields,
+	}
+	queryParamString := c.BuildQuery(queryParams)
+	request, err := c.CreateJSONRequestWithContext(ctx, http.MethodGet, fmt.Sprintf("%s?%s", gridRestartStatusBasePath, queryParamString), nil)
+	if err != nil {
+		return ret, err
+	}
+
+	response := c.Call(request, &gridStatus)
+	if response != nil {
+		return ret, response
+	}
+	if len(gridStatus) == 0 {
+		return ret, errors.New("no grid service restart status found")
+	}
+	ret.Grid = gridStatus[0]
+
+	queryParams = map[string]string{
+		"_return_fields": memberRestartStatusReturnFields,
+	}
+	queryParamString = c.BuildQuery(queryParams)
+	request, err = c.CreateJSONRequestWithContext(ctx, http.MethodGet, fmt.Sprintf("%s?%s", memberRestartStatusBasePath, queryParamString), nil)
+	if err != nil {
+		return ret, err
+	}
+
+	response = c.Call(request, &ret.Members)
+	if response != nil {
+		return ret, response
+	}
+
+	return ret, nil
+}
+
+// Done reports whether no service restarts are pending or in progress
+func (s RestartStatus) Done() bool {
+	return s.Grid.Pending == 0 && s.Grid.Processing == 0 && s.Grid.Restarting == 0
+}
+
+// WaitForRestart polls restart status every interval until restarts complete
+func (c *Client) WaitForRestart(interval time.Duration) (RestartStatus, error) {
+	return c.WaitForRestartWithContext(context.Background(), interval)
+}
+
+// WaitForRestartWithContext polls restart status every interval until restarts complete
+// or the supplied context is done.  An interval of 0 polls every 5 seconds
+func (c *Client) WaitForRestartWithContext(ctx context.Context, interval time.Duration) (RestartStatus, error) {
+	if interval <= 0 {
+		interval = defaultRestartPollInterval
+	}
+	for {
+		status, err := c.GetRestartStatusWithContext(ctx)
+		if err != nil {
+			return status, err
+		}
+		if status.Done() {
+			if status.Grid.Failures > 0 || status.Grid.Timeouts > 0 {
+				return status, fmt.Errorf("service restart finished with %d failures and %d timeouts", status.Grid.Failures, status.Grid.Timeouts)
+			}
+			return status, nil
+		}
+		if err := sleepWithContext(ctx, interval); err != nil {
+			return status, err
+		}
+	}
+}
+
+// GetPendingChanges gets changed objects and member services awaiting a restart
+func (c *Client) GetPendingChanges() (PendingChanges, error) {
+	return c.GetPendingChangesWithContext(context.Background())
+}
+
+// GetPendingChangesWithContext gets changed objects and member services awaiting a restart using the supplied context
+func (c *Client) GetPendingChangesWithContext(ctx context.Context) (PendingChanges, error) {
+	var ret PendingChanges
+	var err error
+
+	ret.Requests, err = listWithReturnFields[ServiceRestartRequest](ctx, c, restartRequestBasePath, restartRequestReturnFields, nil)
+	if err != nil {
+		return ret, err
+	}
+	ret.ChangedObjects, err = listWithReturnFields[ChangedObject](ctx, c, changedObjectBasePath, changedObjectReturnFields, nil)
+	if err != nil {
+		return ret, err
+	}
+
+	return ret, nil
+}
+
+// ServicesByMember returns the services awaiting a restart keyed by member host name
+func (p PendingChanges) ServicesByMember() map[string][]string {
+	ret := map[string][]string{}
+	for _, request := range p.Requests {
+		if request.Needed == "NOT_NEEDED" {
+			continue
+		}
+		found := false
+		for _, service := range ret[request.Member] {
+			if service == request.Service {
+				found = true
+			}
+		}
+		if !found {
+			ret[request.Member] = append(ret[request.Member], request.Service)
+		}
+	}
+	return ret
+}
+
+// RestartPendingServices restarts services on the grid members with pending changes
+// and returns the members restarted
+func (c *Client) RestartPendingServices(grid Grid) ([]GridMember, error) {
+	return c.RestartPendingServicesWithContext(context.Background(), grid)
+}
+
+// RestartPendingServicesWithContext restarts services on the grid members with pending changes
+// and returns the members restarted using the supplied context
+func (c *Client) RestartPendingServicesWithContext(ctx context.Context, grid Grid) ([]GridMember, error) {
+	var ret []GridMember
+
+	pending, err := c.GetPendingChangesWithContext(ctx)
+	if err != nil {
+		return ret, err
+	}
+	servicesByMember := pending.ServicesByMember()
+	if len(servicesByMember) == 0 {
+		return ret, nil
+	}
+
+	members, err := c.GetGridMembersByQueryWithContext(ctx, nil)
+	if err != nil {
+		return ret, err
+	}
+	var services []string
+	for _, member := range members {
+		memberServices, ok := servicesByMember[member.Hostname]
+		if !ok {
+			continue
+		}
+		ret = append(ret, member)
+		for _, service := range memberServices {
+			found := false
+			for _, existing := range services {
+				if existing == service {
+					found = true
+				}
+			}
+			if !found {
+				services = append(services, service)
+			}
+		}
+	}
+	if len(ret) == 0 {
+		return ret, nil
+	}
+
+	err = c.RestartServicesWithContext(ctx, grid.Ref, GridServiceRestartRequest{
+		RestartOption: RestartOptionIfNeeded,
+		Services:      services,
+		Members:       GridMemberHostnames(ret),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return ret, nil
+}
@@ -4,20 +4,13 @@
 package infoblox
 
 import (
-	"os"
+	"context"
 	"testing"
 	"time"
 )
 
 var (
-	gridConfig = Config{
-		Host:                   os.Getenv("INFOBLOX_HOST"),
-		Port:                   os.Getenv("INFOBLOX_PORT"),
-		Username:               os.Getenv("INFOBLOX_USERNAME"),
-		Password:               os.Getenv("INFOBLOX_PASSWORD"),
-		Version:                os.Getenv("INFOBLOX_VERSION"),
-		DisableTLSVerification: true,
-	}
+	gridConfig      = testConfig()
 	gridClient      = New(gridConfig)
 	gridTestNetwork = Network{
 		CIDR:        "172.19.4.0/24",
@@ -45,27 +38,27 @@ var (
 	gridRestartRequest = GridServiceRestartRequest{
 		RestartOption: "RESTART_IF_NEEDED",
 		Services:      []string{"DHCP"},
-		Members:       []string{gridMember.Hostname},
 	}
 )
 
 func TestGridCreateNetwork(t *testing.T) {
-	members, err := gridClient.GetGridMembers(nil)
+	members, err := gridClient.GetGridMembersByQuery(nil)
 	if err != nil {
 		t.Errorf("Error retrieving grid members: %s", err)
 	}
 	gridMember = members[0]
+	gridRestartRequest.Members = GridMemberHostnames(members[:1])
 	gridTestNetwork.Members = []Member{
 		Member{
 			StructType: "dhcpmember",
-			Name:       gridMember.Hostname,
+			Hostname:   gridMember.Hostname,
 		},
 	}
 	err = gridClient.CreateNetwork(&gridTestNetwork)
 	if err != nil {
 		t.Errorf("Error creating network: %s", err)
 	}
-	grids, err := gridClient.GetGrids(nil)
+	grids, err := gridClient.GetGridsByQuery(nil)
 	if err != nil {
 		t.Errorf("Error retrieving grids: %s", err)
 	}
@@ -74,7 +67,12 @@ func TestGridCreateNetwork(t *testing.T) {
 	if err != nil {
 		t.Errorf("Error restarting grid services: %s", err)
 	}
-	time.Sleep(5 * time.Second)
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Minute)
+	defer cancel()
+	_, err = gridClient.WaitForRestartWithContext(ctx, time.Second)
+	if err != nil {
+		t.Errorf("Error waiting for grid services restart: %s", err)
+	}
 }
 
 func TestGridDeleteNetwork(t *testing.T) {
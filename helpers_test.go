@@ -0,0 +1,69 @@
+//go:build all || unittests || specific
+// +build all unittests specific
+
+package infoblox
+
+import (
+	"log"
+	"os"
+	"sync"
+
+	"github.com/techBeck03/infoblox-go-sdk/infobloxtest"
+)
+
+var (
+	fakeServerOnce sync.Once
+	fakeServer     *infobloxtest.Server
+)
+
+// testConfig returns the grid configuration used by the tests.  When
+// INFOBLOX_HOST is unset an in-process fake WAPI server is started instead
+func testConfig() Config {
+	if os.Getenv("INFOBLOX_HOST") != "" {
+		config, err := ConfigFromEnv()
+		if err != nil {
+			log.Fatalf("Error loading grid configuration: %s", err)
+		}
+		config.DisableTLSVerification = true
+		return config
+	}
+	fakeServerOnce.Do(startFakeServer)
+	return Config{
+		Host:                   fakeServer.Host(),
+		Port:                   fakeServer.Port(),
+		Username:               fakeServer.Username,
+		Password:               fakeServer.Password,
+		Version:                infobloxtest.Version,
+		DisableTLSVerification: true,
+	}
+}
+
+// startFakeServer starts the fake grid seeded with the objects the
+// tests expect to already exist on a lab grid
+func startFakeServer() {
+	fakeServer = infobloxtest.NewServer()
+	fakeServer.Username = "admin"
+	fakeServer.Password = "infoblox"
+	seeds := []struct {
+		objectType string
+		fields     map[string]interface{}
+	}{
+		{"extensibleattributedef", map[string]interface{}{"name": "Owner", "type": "STRING"}},
+		{"extensibleattributedef", map[string]interface{}{"name": "Gateway", "type": "STRING"}},
+		{"extensibleattributedef", map[string]interface{}{"name": "Location", "type": "STRING"}},
+		{"extensibleattributedef", map[string]interface{}{"name": "Label", "type": "STRING"}},
+		{"networkcontainer", map[string]interface{}{"network": "172.20.0.0/14"}},
+		{"networkcontainer", map[string]interface{}{
+			"network": "10.200.0.0/16",
+			"extattrs": map[string]interface{}{
+				"Label": map[string]interface{}{"value": "Autonets"},
+			},
+		}},
+		{"network", map[string]interface{}{"network": "172.16.106.0/24"}},
+	}
+	for _, seed := range seeds {
+		if _, err := fakeServer.Add(seed.objectType, seed.fields); err != nil {
+			log.Fatalf("Error seeding fake server: %s", err)
+		}
+	}
+}
@@ -1,36 +1,36 @@
 package infoblox
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 )
 
 const (
 	hostRecordBasePath     = "record:host"
-	hostRecordReturnFields = "name,view,network_view,configure_for_dns,comment,zone,ipv4addrs,ipv4addrs.host,ipv4addrs.network,ipv4addrs.ipv4addr,ipv4addrs.mac,ipv4addrs.configure_for_dhcp,ipv4addrs.nextserver,ipv4addrs.use_for_ea_inheritance,extattrs"
+	hostRecordReturnFields = "name,view,network_view,configure_for_dns,comment,zone,ipv4addrs,ipv4addrs.host,ipv4addrs.network,ipv4addrs.ipv4addr,ipv4addrs.mac,ipv4addrs.configure_for_dhcp,ipv4addrs.nextserver,ipv4addrs.use_for_ea_inheritance,ipv6addrs,ipv6addrs.host,ipv6addrs.ipv6addr,ipv6addrs.duid,ipv6addrs.address_type,ipv6addrs.ipv6prefix,ipv6addrs.ipv6prefix_bits,ipv6addrs.configure_for_dhcp,extattrs"
 )
 
 // GetHostRecordByRef gets host record by reference
 func (c *Client) GetHostRecordByRef(ref string, queryParams map[string]string) (HostRecord, error) {
+	return c.GetHostRecordByRefWithContext(context.Background(), ref, queryParams)
+}
+
+// GetHostRecordByRefWithContext gets host record by reference using the supplied context
+func (c *Client) GetHostRecordByRefWithContext(ctx context.Context, ref string, queryParams map[string]string) (HostRecord, error) {
 	var ret HostRecord
 
-	if queryParams == nil {
-		queryParams = map[string]string{
-			"_return_fields": hostRecordReturnFields,
-		}
-	} else {
-		queryParams["_return_fields"] = hostRecordReturnFields
-	}
+	queryParams = returnFields(queryParams, hostRecordReturnFields)
 
 	queryParamString := c.BuildQuery(queryParams)
-	request, err := c.CreateJSONRequest(http.MethodGet, fmt.Sprintf("%s?%s", ref, queryParamString), nil)
+	request, err := c.CreateJSONRequestWithContext(ctx, http.MethodGet, fmt.Sprintf("%s?%s", ref, queryParamString), nil)
 	if err != nil {
 		return ret, err
 	}
 
 	response := c.Call(request, &ret)
 	if response != nil {
-		return ret, fmt.Errorf(response.ErrorMessage)
+		return ret, response
 	}
 
 	return ret, nil
@@ -38,76 +38,91 @@ func (c *Client) GetHostRecordByRef(ref string, queryParams map[string]string) (
 
 // GetHostRecordByQuery gets host record by query parameters
 func (c *Client) GetHostRecordByQuery(queryParams map[string]string) ([]HostRecord, error) {
-	var ret HostRecordQueryResult
-	queryParams["_return_fields"] = hostRecordReturnFields
-	queryParams["_return_as_object"] = "1"
-	queryParams["_paging"] = "0"
-	queryParams["_max_results"] = "2"
+	return c.GetHostRecordByQueryWithContext(context.Background(), queryParams)
+}
 
-	queryParamString := c.BuildQuery(queryParams)
-	request, err := c.CreateJSONRequest(http.MethodGet, fmt.Sprintf("%s?%s", hostRecordBasePath, queryParamString), nil)
-	if err != nil {
-		return nil, err
-	}
+// GetHostRecordByQueryWithContext gets host record by query parameters using the supplied context
+func (c *Client) GetHostRecordByQueryWithContext(ctx context.Context, queryParams map[string]string) ([]HostRecord, error) {
+	return c.ListHostRecordsWithContext(ctx, queryParams)
+}
 
-	response := c.Call(request, &ret)
-	if response != nil {
-		return nil, fmt.Errorf(response.ErrorMessage)
-	}
+// ListHostRecords lists all host records matching query parameters following every result page
+func (c *Client) ListHostRecords(queryParams map[string]string) ([]HostRecord, error) {
+	return c.ListHostRecordsWithContext(context.Background(), queryParams)
+}
 
-	return ret.Results, nil
+// ListHostRecordsWithContext lists all host records matching query parameters following every result page using the supplied context
+func (c *Client) ListHostRecordsWithContext(ctx context.Context, queryParams map[string]string) ([]HostRecord, error) {
+	return listWithReturnFields[HostRecord](ctx, c, hostRecordBasePath, hostRecordReturnFields, queryParams)
 }
 
 // CreateHostRecord creates host record
 func (c *Client) CreateHostRecord(hostRecord *HostRecord) error {
+	return c.CreateHostRecordWithContext(context.Background(), hostRecord)
+}
+
+// CreateHostRecordWithContext creates host record using the supplied context
+func (c *Client) CreateHostRecordWithContext(ctx context.Context, hostRecord *HostRecord) error {
+	hostRecord.View = c.dnsView(hostRecord.View)
+	hostRecord.NetworkView = c.networkView(hostRecord.NetworkView)
 	queryParams := map[string]string{
 		"_return_fields": hostRecordReturnFields,
 	}
 	queryParamString := c.BuildQuery(queryParams)
-	request, err := c.CreateJSONRequest(http.MethodPost, fmt.Sprintf("%s?%s", hostRecordBasePath, queryParamString), hostRecord)
+	request, err := c.CreateJSONRequestWithContext(ctx, http.MethodPost, fmt.Sprintf("%s?%s", hostRecordBasePath, queryParamString), hostRecord)
 	if err != nil {
 		return err
 	}
 
 	response := c.Call(request, &hostRecord)
 	if response != nil {
-		return fmt.Errorf(response.ErrorMessage)
+		return response
 	}
 	return nil
 }
 
 // UpdateHostRecord creates host record
 func (c *Client) UpdateHostRecord(ref string, hostRecord HostRecord) (HostRecord, error) {
+	return c.UpdateHostRecordWithContext(context.Background(), ref, hostRecord)
+}
+
+// UpdateHostRecordWithContext creates host record using the supplied context
+func (c *Client) UpdateHostRecordWithContext(ctx context.Context, ref string, hostRecord HostRecord) (HostRecord, error) {
 	var ret HostRecord
 	queryParams := map[string]string{
 		"_return_fields": hostRecordReturnFields,
 	}
 	queryParamString := c.BuildQuery(queryParams)
-	request, err := c.CreateJSONRequest(http.MethodPut, fmt.Sprintf("%s?%s", ref, queryParamString), hostRecord)
+	request, err := c.CreateJSONRequestWithContext(ctx, http.MethodPut, fmt.Sprintf("%s?%s", ref, queryParamString), hostRecord)
 	if err != nil {
 		return ret, err
 	}
 
 	response := c.Call(request, &ret)
 	if response != nil {
-		return ret, fmt.Errorf(response.ErrorMessage)
+		return ret, response
 	}
 	return ret, nil
 }
 
 // DeleteHostRecord creates host record
 func (c *Client) DeleteHostRecord(ref string) error {
-	request, err := c.CreateJSONRequest(http.MethodDelete, fmt.Sprintf("%s", ref), nil)
+	return c.DeleteHostRecordWithContext(context.Background(), ref)
+}
+
+// DeleteHostRecordWithContext creates host record using the supplied context
+func (c *Client) DeleteHostRecordWithContext(ctx context.Context, ref string) error {
+	request, err := c.CreateJSONRequestWithContext(ctx, http.MethodDelete, fmt.Sprintf("%s", ref), nil)
 	if err != nil {
 		return err
 	}
 
 	response := c.Call(request, nil)
 	if response != nil {
-		if response.StatusCode == 404 {
+		if IsNotFound(response) {
 			return nil
 		}
-		return err
+		return response
 	}
 	return nil
 }
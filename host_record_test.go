@@ -4,19 +4,11 @@
 package infoblox
 
 import (
-	"os"
 	"testing"
 )
 
 var (
-	hostRecordConfig = Config{
-		Host:                   os.Getenv("INFOBLOX_HOST"),
-		Port:                   os.Getenv("INFOBLOX_PORT"),
-		Username:               os.Getenv("INFOBLOX_USERNAME"),
-		Password:               os.Getenv("INFOBLOX_PASSWORD"),
-		Version:                os.Getenv("INFOBLOX_VERSION"),
-		DisableTLSVerification: true,
-	}
+	hostRecordConfig = testConfig()
 	hostRecordClient = New(hostRecordConfig)
 	testHostRecord   = HostRecord{
 		Hostname:    "test-api-1.auslab.cisco.com",
@@ -0,0 +1,361 @@
+package infobloxtest
+
+import (
+	"fmt"
+	"math/big"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// parseIP converts an address to an integer, returning nil when invalid
+func parseIP(address string) *big.Int {
+	ip := net.ParseIP(address)
+	if ip == nil {
+		return nil
+	}
+	if v4 := ip.To4(); v4 != nil {
+		return new(big.Int).SetBytes(v4)
+	}
+	return new(big.Int).SetBytes(ip.To16())
+}
+
+// formatIP converts an integer back to an address of the supplied family
+func formatIP(value *big.Int, v4 bool) string {
+	size := net.IPv6len
+	if v4 {
+		size = net.IPv4len
+	}
+	raw := value.Bytes()
+	buf := make([]byte, size)
+	copy(buf[size-len(raw):], raw)
+	return net.IP(buf).String()
+}
+
+// ipNetwork is a parsed CIDR with integer bounds
+type ipNetwork struct {
+	cidr   string
+	v4     bool
+	prefix int
+	bits   int
+	first  *big.Int
+	last   *big.Int
+}
+
+func parseNetwork(cidr string) (*ipNetwork, error) {
+	ip, network, err := net.ParseCIDR(cidr)
+	if err != nil {
+		return nil, err
+	}
+	if !ip.Equal(network.IP) {
+		return nil, fmt.Errorf("%s is not a network address", cidr)
+	}
+	prefix, bits := network.Mask.Size()
+	first := new(big.Int).SetBytes(network.IP)
+	size := new(big.Int).Lsh(big.NewInt(1), uint(bits-prefix))
+	last := new(big.Int).Sub(new(big.Int).Add(first, size), big.NewInt(1))
+	return &ipNetwork{
+		cidr:   network.String(),
+		v4:     bits == 32,
+		prefix: prefix,
+		bits:   bits,
+		first:  first,
+		last:   last,
+	}, nil
+}
+
+func (n *ipNetwork) contains(value *big.Int) bool {
+	return value != nil && value.Cmp(n.first) >= 0 && value.Cmp(n.last) <= 0
+}
+
+func (n *ipNetwork) overlaps(other *ipNetwork) bool {
+	return n.v4 == other.v4 && n.first.Cmp(other.last) <= 0 && other.first.Cmp(n.last) <= 0
+}
+
+// hostBounds returns the assignable address bounds excluding the network
+// and broadcast addresses of ipv4 networks and the subnet router anycast
+// address of ipv6 networks
+func (n *ipNetwork) hostBounds() (*big.Int, *big.Int) {
+	first, last := new(big.Int).Set(n.first), new(big.Int).Set(n.last)
+	if n.v4 && n.prefix < 31 {
+		first.Add(first, big.NewInt(1))
+		last.Sub(last, big.NewInt(1))
+	}
+	if !n.v4 && n.prefix < 127 {
+		first.Add(first, big.NewInt(1))
+	}
+	return first, last
+}
+
+// addressUsage describes an object referencing an address
+type addressUsage struct {
+	name       string
+	ref        string
+	objectType string
+	usage      string
+	duid       string
+}
+
+// addressObjects lists the object types and address fields that consume addresses
+var addressObjects = []struct {
+	objectType string
+	field      string
+	typeName   string
+	usage      string
+}{
+	{"record:a", "ipv4addr", "A", "DNS"},
+	{"record:aaaa", "ipv6addr", "AAAA", "DNS"},
+	{"record:ptr", "ipv4addr", "PTR", "DNS"},
+	{"record:ptr", "ipv6addr", "PTR", "DNS"},
+	{"fixedaddress", "ipv4addr", "FA", "DHCP"},
+	{"ipv6fixedaddress", "ipv6addr", "FA", "DHCP"},
+}
+
+// usedAddresses maps addresses in the supplied network view to the objects using them
+func (s *Server) usedAddresses(networkView string) map[string][]addressUsage {
+	ret := map[string][]addressUsage{}
+	add := func(address string, usage addressUsage) {
+		if value := parseIP(address); value != nil {
+			key := value.String()
+			ret[key] = append(ret[key], usage)
+		}
+	}
+	for _, host := range s.objects["record:host"] {
+		if host.str("network_view") != networkView {
+			continue
+		}
+		for _, field := range []string{"ipv4addr", "ipv6addr"} {
+			addrs, _ := host[field+"s"].([]interface{})
+			for _, raw := range addrs {
+				addr, _ := raw.(map[string]interface{})
+				add(stringValue(addr[field]), addressUsage{
+					name:       host.str("name"),
+					ref:        host.ref(),
+					objectType: "HOST",
+					usage:      "DNS",
+					duid:       stringValue(addr["duid"]),
+				})
+			}
+		}
+	}
+	for _, definition := range addressObjects {
+		for _, obj := range s.objects[definition.objectType] {
+			view := obj.str("network_view")
+			if view == "" {
+				view = s.viewNetworkView(obj.str("view"))
+			}
+			if view != networkView {
+				continue
+			}
+			add(obj.str(definition.field), addressUsage{
+				name:       obj.str("name"),
+				ref:        obj.ref(),
+				objectType: definition.typeName,
+				usage:      definition.usage,
+				duid:       obj.str("duid"),
+			})
+		}
+	}
+	return ret
+}
+
+// maxListedAddresses bounds the number of addresses a single ipv4address
+// or ipv6address search may enumerate
+const maxListedAddresses = 1 << 16
+
+// listAddresses returns a list function computing ipv4address or
+// ipv6address objects for the queried network.  Large networks must be
+// narrowed with ip_address> and ip_address< bounds
+func listAddresses(v4 bool) func(s *Server, query url.Values) ([]object, *wapiError) {
+	objectType := "ipv6address"
+	if v4 {
+		objectType = "ipv4address"
+	}
+	return func(s *Server, query url.Values) ([]object, *wapiError) {
+		cidr := query.Get("network")
+		if cidr == "" {
+			return nil, protoError(http.StatusBadRequest, "%s searches require the network argument", objectType)
+		}
+		network, err := parseNetwork(cidr)
+		if err != nil || network.v4 != v4 {
+			return nil, protoError(http.StatusBadRequest, "Invalid network %s", cidr)
+		}
+		networkView := query.Get("network_view")
+		if networkView == "" {
+			networkView = "default"
+		}
+		first, last := new(big.Int).Set(network.first), new(big.Int).Set(network.last)
+		if lower := parseIP(query.Get("ip_address>")); lower != nil && lower.Cmp(first) > 0 {
+			first = lower
+		}
+		if upper := parseIP(query.Get("ip_address<")); upper != nil && upper.Cmp(last) < 0 {
+			last = upper
+		}
+		span := new(big.Int).Sub(last, first)
+		if span.Cmp(big.NewInt(maxListedAddresses)) >= 0 {
+			return nil, protoError(http.StatusBadRequest, "Network %s is too large to enumerate", cidr)
+		}
+
+		used := s.usedAddresses(networkView)
+		var ret []object
+		one := big.NewInt(1)
+		for value := new(big.Int).Set(first); value.Cmp(last) <= 0; value = new(big.Int).Add(value, one) {
+			address := formatIP(value, v4)
+			obj := object{
+				"_ref":         fmt.Sprintf("%s/%s:%s/%s", objectType, encodeID(objectType, address), address, networkView),
+				"ip_address":   address,
+				"network":      network.cidr,
+				"network_view": networkView,
+				"status":       "UNUSED",
+				"names":        []interface{}{},
+				"objects":      []interface{}{},
+				"types":        []interface{}{},
+				"usage":        []interface{}{},
+			}
+			var names, objects, types, usages []interface{}
+			if v4 && network.prefix < 31 && value.Cmp(network.first) == 0 {
+				types = append(types, "NETWORK")
+			}
+			if v4 && network.prefix < 31 && value.Cmp(network.last) == 0 {
+				types = append(types, "BROADCAST")
+			}
+			for _, usage := range used[value.String()] {
+				if usage.name != "" && !containsValue(names, usage.name) {
+					names = append(names, usage.name)
+				}
+				objects = append(objects, usage.ref)
+				if !containsValue(types, usage.objectType) {
+					types = append(types, usage.objectType)
+				}
+				if !containsValue(usages, usage.usage) {
+					usages = append(usages, usage.usage)
+				}
+				if !v4 && usage.duid != "" {
+					obj["duid"] = usage.duid
+				}
+			}
+			if len(types) > 0 {
+				obj["status"] = "USED"
+				obj["names"] = nonNil(names)
+				obj["objects"] = nonNil(objects)
+				obj["types"] = types
+				obj["usage"] = nonNil(usages)
+			}
+			ret = append(ret, obj)
+		}
+		return ret, nil
+	}
+}
+
+func containsValue(list []interface{}, value string) bool {
+	for _, item := range list {
+		if stringValue(item) == value {
+			return true
+		}
+	}
+	return false
+}
+
+func nonNil(list []interface{}) []interface{} {
+	if list == nil {
+		return []interface{}{}
+	}
+	return list
+}
+
+// nextAvailableIPs returns up to num unused addresses between first and last
+func (s *Server) nextAvailableIPs(networkView string, first *big.Int, last *big.Int, v4 bool, num int, exclude []string) []string {
+	used := s.usedAddresses(networkView)
+	excluded := map[string]bool{}
+	for _, address := range exclude {
+		if value := parseIP(address); value != nil {
+			excluded[value.String()] = true
+		}
+	}
+	var ret []string
+	one := big.NewInt(1)
+	for value := new(big.Int).Set(first); value.Cmp(last) <= 0 && len(ret) < num; value = new(big.Int).Add(value, one) {
+		key := value.String()
+		if len(used[key]) > 0 || excluded[key] {
+			continue
+		}
+		ret = append(ret, formatIP(value, v4))
+	}
+	return ret
+}
+
+// nextAvailableNetworks returns up to num free subnets of the supplied prefix within container
+func (s *Server) nextAvailableNetworks(container object, prefix int, num int, exclude []string) ([]string, *wapiError) {
+	parent, err := parseNetwork(container.str("network"))
+	if err != nil {
+		return nil, dataError("Invalid container network %s", container.str("network"))
+	}
+	if prefix <= parent.prefix || prefix > parent.bits {
+		return nil, protoError(http.StatusBadRequest, "Invalid cidr %d for container %s", prefix, parent.cidr)
+	}
+	networkView := container.str("network_view")
+	var taken []*ipNetwork
+	for _, objectType := range []string{"network", "networkcontainer", "ipv6network", "ipv6networkcontainer"} {
+		for _, obj := range s.objects[objectType] {
+			if obj.str("network_view") != networkView || refID(obj.ref()) == refID(container.ref()) {
+				continue
+			}
+			existing, err := parseNetwork(obj.str("network"))
+			if err != nil {
+				continue
+			}
+			// Containers enclosing the parent do not consume its space
+			if strings.HasSuffix(objectType, "container") && existing.prefix <= parent.prefix {
+				continue
+			}
+			taken = append(taken, existing)
+		}
+	}
+	for _, cidr := range exclude {
+		if existing, err := parseNetwork(cidr); err == nil {
+			taken = append(taken, existing)
+		}
+	}
+
+	var ret []string
+	step := new(big.Int).Lsh(big.NewInt(1), uint(parent.bits-prefix))
+	for value := new(big.Int).Set(parent.first); value.Cmp(parent.last) <= 0 && len(ret) < num; value = new(big.Int).Add(value, step) {
+		candidate, _ := parseNetwork(fmt.Sprintf("%s/%d", formatIP(value, parent.v4), prefix))
+		free := true
+		for _, existing := range taken {
+			if candidate.overlaps(existing) {
+				free = false
+				break
+			}
+		}
+		if free {
+			ret = append(ret, candidate.cidr)
+			taken = append(taken, candidate)
+		}
+	}
+	if len(ret) == 0 {
+		return nil, dataError("Cannot find %d available network(s) in container %s", num, parent.cidr)
+	}
+	return ret, nil
+}
+
+// containingNetwork returns the network object in networkView containing address
+func (s *Server) containingNetwork(objectType string, networkView string, address string) object {
+	value := parseIP(address)
+	var best object
+	bestPrefix := -1
+	for _, obj := range s.objects[objectType] {
+		if obj.str("network_view") != networkView {
+			continue
+		}
+		network, err := parseNetwork(obj.str("network"))
+		if err != nil || !network.contains(value) {
+			continue
+		}
+		if network.prefix > bestPrefix {
+			best, bestPrefix = obj, network.prefix
+		}
+	}
+	return best
+}
@@ -0,0 +1,208 @@
+package infobloxtest
+
+import (
+	"math/big"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+const nextAvailableIPPrefix = "func:nextavailableip:"
+
+// callFunction executes a WAPI _function call
+func (s *Server) callFunction(objectType string, target string, isRef bool, function string, body object) (interface{}, *wapiError) {
+	if !isRef {
+		return nil, protoError(http.StatusBadRequest, "Function %s requires an object reference", function)
+	}
+	obj, werr := s.lookup(target)
+	if werr != nil {
+		return nil, werr
+	}
+	num := intParameter(body, "num", 1)
+	exclude := stringListParameter(body, "exclude")
+
+	switch {
+	case function == "restartservices" && objectType == "grid":
+		return s.restartServices(body)
+	case function == "next_available_ip":
+		ips, werr := s.nextAvailableIPsIn(objectType, obj, num, exclude)
+		if werr != nil {
+			return nil, werr
+		}
+		return map[string]interface{}{"ips": ips}, nil
+	case function == "next_available_network" && strings.HasSuffix(objectType, "networkcontainer"):
+		networks, werr := s.nextAvailableNetworks(obj, intParameter(body, "cidr", 0), num, exclude)
+		if werr != nil {
+			return nil, werr
+		}
+		return map[string]interface{}{"networks": networks}, nil
+	}
+	return nil, protoError(http.StatusBadRequest, "Function %s is not valid for %s", function, objectType)
+}
+
+// nextAvailableIPsIn allocates addresses from a network or range object
+func (s *Server) nextAvailableIPsIn(objectType string, obj object, num int, exclude []string) ([]string, *wapiError) {
+	var first, last *big.Int
+	v4 := true
+	switch objectType {
+	case "network", "ipv6network":
+		network, err := parseNetwork(obj.str("network"))
+		if err != nil {
+			return nil, dataError("Invalid network %s", obj.str("network"))
+		}
+		first, last = network.hostBounds()
+		v4 = network.v4
+	case "range", "ipv6range":
+		first, last = parseIP(obj.str("start_addr")), parseIP(obj.str("end_addr"))
+		v4 = objectType == "range"
+	default:
+		return nil, protoError(http.StatusBadRequest, "Function next_available_ip is not valid for %s", objectType)
+	}
+	ips := s.nextAvailableIPs(obj.str("network_view"), first, last, v4, num, exclude)
+	if len(ips) < num {
+		return nil, dataError("Cannot find %d available IP address(es) in %s", num, obj.ref())
+	}
+	return ips, nil
+}
+
+// resolveObjectFunction evaluates an _object_function field value and
+// returns the first result
+func (s *Server) resolveObjectFunction(function map[string]interface{}) (string, *wapiError) {
+	name := stringValue(function["_object_function"])
+	objectType := stringValue(function["_object"])
+	resultField := stringValue(function["_result_field"])
+	query := url.Values{}
+	if parameters, ok := function["_object_parameters"].(map[string]interface{}); ok {
+		for key, value := range parameters {
+			query.Set(key, stringValue(value))
+		}
+	}
+	var target object
+	for _, obj := range s.objects[objectType] {
+		ok, werr := matches(obj, query)
+		if werr != nil {
+			return "", werr
+		}
+		if ok {
+			target = obj
+			break
+		}
+	}
+	if target == nil {
+		return "", dataError("No %s matched the object function parameters", objectType)
+	}
+	parameters, _ := function["_parameters"].(map[string]interface{})
+	result, werr := s.callFunction(objectType, target.ref(), true, name, object(parameters))
+	if werr != nil {
+		return "", werr
+	}
+	values, _ := result.(map[string]interface{})[resultField].([]string)
+	if len(values) == 0 {
+		return "", dataError("Object function %s returned no %s", name, resultField)
+	}
+	return values[0], nil
+}
+
+// resolveAddress returns a literal address or allocates one for
+// func:nextavailableip strings and _object_function values
+func (s *Server) resolveAddress(value interface{}, networkView string, exclude []string) (string, *wapiError) {
+	if function, ok := value.(map[string]interface{}); ok {
+		parameters, _ := function["_parameters"].(map[string]interface{})
+		if parameters == nil {
+			parameters = map[string]interface{}{}
+		}
+		if len(exclude) > 0 {
+			merged := stringListParameter(object(parameters), "exclude")
+			for _, address := range exclude {
+				merged = append(merged, address)
+			}
+			parameters["exclude"] = toInterfaceList(merged)
+		}
+		function["_parameters"] = parameters
+		return s.resolveObjectFunction(function)
+	}
+	address := stringValue(value)
+	if !strings.HasPrefix(address, nextAvailableIPPrefix) {
+		if parseIP(address) == nil {
+			return "", protoError(http.StatusBadRequest, "Invalid IP address %s", address)
+		}
+		return address, nil
+	}
+
+	spec := strings.TrimPrefix(address, nextAvailableIPPrefix)
+	if target, view, found := strings.Cut(spec, ","); found {
+		spec, networkView = target, view
+	}
+	var ips []string
+	var werr *wapiError
+	switch {
+	case strings.Contains(spec, "/") && parseIP(strings.Split(spec, "/")[0]) != nil:
+		var target object
+		for _, objectType := range []string{"network", "ipv6network"} {
+			for _, obj := range s.objects[objectType] {
+				if obj.str("network") == spec && obj.str("network_view") == networkView {
+					target = obj
+				}
+			}
+			if target != nil {
+				ips, werr = s.nextAvailableIPsIn(objectType, target, 1, exclude)
+				break
+			}
+		}
+		if target == nil {
+			return "", dataError("Network %s not found in network view %s", spec, networkView)
+		}
+	case strings.Contains(spec, "-"):
+		start, end, _ := strings.Cut(spec, "-")
+		first, last := parseIP(start), parseIP(end)
+		if first == nil || last == nil {
+			return "", protoError(http.StatusBadRequest, "Invalid address range %s", spec)
+		}
+		ips = s.nextAvailableIPs(networkView, first, last, !strings.Contains(start, ":"), 1, exclude)
+	default:
+		obj, lookupErr := s.lookup(spec)
+		if lookupErr != nil {
+			return "", lookupErr
+		}
+		objectType, _ := splitTarget(spec)
+		ips, werr = s.nextAvailableIPsIn(objectType, obj, 1, exclude)
+	}
+	if werr != nil {
+		return "", werr
+	}
+	if len(ips) == 0 {
+		return "", dataError("Cannot find an available IP address in %s", spec)
+	}
+	return ips[0], nil
+}
+
+func intParameter(body object, name string, fallback int) int {
+	if body == nil {
+		return fallback
+	}
+	if value, err := strconv.Atoi(stringValue(body[name])); err == nil {
+		return value
+	}
+	return fallback
+}
+
+func stringListParameter(body object, name string) []string {
+	var ret []string
+	if body == nil {
+		return ret
+	}
+	list, _ := body[name].([]interface{})
+	for _, item := range list {
+		ret = append(ret, stringValue(item))
+	}
+	return ret
+}
+
+func toInterfaceList(values []string) []interface{} {
+	ret := make([]interface{}, 0, len(values))
+	for _, value := range values {
+		ret = append(ret, value)
+	}
+	return ret
+}
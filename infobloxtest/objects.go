@@ -0,0 +1,972 @@
+package infobloxtest
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// objectType describes how the fake server stores a WAPI object type
+type objectType struct {
+	// basicFields are returned when _return_fields is not supplied
+	basicFields []string
+	// refName renders the readable suffix of a reference
+	refName func(obj object) string
+	// key returns the uniqueness key of an object, objects sharing a
+	// keySpace may not share a key
+	key      func(obj object) string
+	keySpace string
+	// prepare fills defaults, computes read only fields and resolves
+	// object functions before an object is stored
+	prepare func(s *Server, obj object, selfRef string) *wapiError
+	// list computes virtual objects that are never stored
+	list func(s *Server, query url.Values) ([]object, *wapiError)
+	// expand computes read only fields derived from other objects before
+	// an object is returned
+	expand func(s *Server, obj object)
+	// service is the grid service that must be restarted for changes
+	// to this object type to take effect
+	service string
+}
+
+var objectTypes = map[string]objectType{
+	"grid": {
+		refName: func(obj object) string { return obj.str("name") },
+	},
+	"member": {
+		basicFields: []string{"config_addr_type", "host_name", "platform", "service_type_configuration"},
+		refName:     func(obj object) string { return obj.str("host_name") },
+		key:         func(obj object) string { return obj.str("host_name") },
+	},
+	"extensibleattributedef": {
+		basicFields: []string{"comment", "default_value", "name", "type"},
+		refName:     func(obj object) string { return obj.str("name") },
+		key:         func(obj object) string { return obj.str("name") },
+	},
+	"network": {
+		basicFields: []string{"comment", "network", "network_view"},
+		refName:     networkRefName,
+		key:         networkKey,
+		keySpace:    "network",
+		prepare:     prepareNetwork("network"),
+		service:     "DHCP",
+	},
+	"networkcontainer": {
+		basicFields: []string{"comment", "network", "network_view"},
+		refName:     networkRefName,
+		key:         networkKey,
+		keySpace:    "network",
+		prepare:     prepareNetwork("networkcontainer"),
+	},
+	"ipv6network": {
+		basicFields: []string{"comment", "network", "network_view"},
+		refName:     networkRefName,
+		key:         networkKey,
+		keySpace:    "network",
+		prepare:     prepareNetwork("ipv6network"),
+		service:     "DHCPV6",
+	},
+	"ipv6networkcontainer": {
+		basicFields: []string{"comment", "network", "network_view"},
+		refName:     networkRefName,
+		key:         networkKey,
+		keySpace:    "network",
+		prepare:     prepareNetwork("ipv6networkcontainer"),
+	},
+	"range": {
+		basicFields: []string{"comment", "end_addr", "network", "network_view", "start_addr"},
+		refName: func(obj object) string {
+			return fmt.Sprintf("%s/%s/%s", obj.str("start_addr"), obj.str("end_addr"), obj.str("network_view"))
+		},
+		key: func(obj object) string {
+			return fmt.Sprintf("%s/%s/%s", obj.str("start_addr"), obj.str("end_addr"), obj.str("network_view"))
+		},
+		prepare: prepareRange("range"),
+		service: "DHCP",
+	},
+	"fixedaddress": {
+		basicFields: []string{"ipv4addr", "network_view"},
+		refName: func(obj object) string {
+			return fmt.Sprintf("%s/%s", obj.str("ipv4addr"), obj.str("network_view"))
+		},
+		key: func(obj object) string {
+			return fmt.Sprintf("%s/%s", obj.str("ipv4addr"), obj.str("network_view"))
+		},
+		prepare: prepareFixedAddress,
+		service: "DHCP",
+	},
+	"ipv6range": {
+		basicFields: []string{"comment", "end_addr", "network", "network_view", "start_addr"},
+		refName: func(obj object) string {
+			return fmt.Sprintf("%s/%s/%s", obj.str("start_addr"), obj.str("end_addr"), obj.str("network_view"))
+		},
+		key: func(obj object) string {
+			return fmt.Sprintf("%s/%s/%s", obj.str("start_addr"), obj.str("end_addr"), obj.str("network_view"))
+		},
+		prepare: prepareRange("ipv6range"),
+		service: "DHCPV6",
+	},
+	"ipv6fixedaddress": {
+		basicFields: []string{"duid", "ipv6addr", "network_view"},
+		refName: func(obj object) string {
+			return fmt.Sprintf("%s/%s", obj.str("ipv6addr"), obj.str("network_view"))
+		},
+		key: func(obj object) string {
+			return fmt.Sprintf("%s/%s", obj.str("ipv6addr"), obj.str("network_view"))
+		},
+		prepare: prepareIPv6FixedAddress,
+		service: "DHCPV6",
+	},
+	"record:host": {
+		basicFields: []string{"ipv4addrs", "ipv6addrs", "name", "view"},
+		refName:     recordRefName,
+		key: func(obj object) string {
+			return strings.ToLower(obj.str("name")) + "/" + obj.str("view")
+		},
+		prepare: prepareHostRecord,
+		service: "DNS",
+	},
+	"record:a": {
+		basicFields: []string{"ipv4addr", "name", "view"},
+		refName:     recordRefName,
+		key: func(obj object) string {
+			return fmt.Sprintf("%s/%s/%s", strings.ToLower(obj.str("name")), obj.str("ipv4addr"), obj.str("view"))
+		},
+		prepare: prepareARecord,
+		service: "DNS",
+	},
+	"record:aaaa": {
+		basicFields: []string{"ipv6addr", "name", "view"},
+		refName:     recordRefName,
+		key: func(obj object) string {
+			return fmt.Sprintf("%s/%s/%s", strings.ToLower(obj.str("name")), obj.str("ipv6addr"), obj.str("view"))
+		},
+		prepare: prepareAAAARecord,
+		service: "DNS",
+	},
+	"record:cname": {
+		basicFields: []string{"canonical", "name", "view"},
+		refName:     recordRefName,
+		key: func(obj object) string {
+			return strings.ToLower(obj.str("name")) + "/" + obj.str("view")
+		},
+		prepare: prepareCNameRecord,
+		service: "DNS",
+	},
+	"record:ptr": {
+		basicFields: []string{"ptrdname", "view"},
+		refName:     recordRefName,
+		key: func(obj object) string {
+			return fmt.Sprintf("%s/%s/%s", strings.ToLower(obj.str("name")), strings.ToLower(obj.str("ptrdname")), obj.str("view"))
+		},
+		prepare: preparePtrRecord,
+		service: "DNS",
+	},
+	"record:alias": {
+		basicFields: []string{"name", "target_name", "target_type", "view"},
+		refName:     recordRefName,
+		key: func(obj object) string {
+			return fmt.Sprintf("%s/%s/%s", strings.ToLower(obj.str("name")), obj.str("target_type"), obj.str("view"))
+		},
+		prepare: prepareAliasRecord,
+		service: "DNS",
+	},
+	"record:mx": {
+		basicFields: []string{"mail_exchanger", "name", "preference", "view"},
+		refName:     recordRefName,
+		key: func(obj object) string {
+			return fmt.Sprintf("%s/%s/%s/%s", strings.ToLower(obj.str("name")), strings.ToLower(obj.str("mail_exchanger")),
+				obj.str("preference"), obj.str("view"))
+		},
+		prepare: prepareMXRecord,
+		service: "DNS",
+	},
+	"record:txt": {
+		basicFields: []string{"name", "text", "view"},
+		refName:     recordRefName,
+		key: func(obj object) string {
+			return fmt.Sprintf("%s/%s/%s", strings.ToLower(obj.str("name")), obj.str("text"), obj.str("view"))
+		},
+		prepare: prepareTXTRecord,
+		service: "DNS",
+	},
+	"record:srv": {
+		basicFields: []string{"name", "port", "priority", "target", "view", "weight"},
+		refName: func(obj object) string {
+			return fmt.Sprintf("%s/%s/%s/%s/%s/%s", obj.str("name"), obj.str("priority"), obj.str("weight"), obj.str("port"),
+				obj.str("target"), obj.str("view"))
+		},
+		key: func(obj object) string {
+			return fmt.Sprintf("%s/%s/%s/%s/%s/%s", strings.ToLower(obj.str("name")), obj.str("priority"), obj.str("weight"),
+				obj.str("port"), strings.ToLower(obj.str("target")), obj.str("view"))
+		},
+		prepare: prepareSRVRecord,
+		service: "DNS",
+	},
+	"record:ns": {
+		basicFields: []string{"addresses", "name", "nameserver", "view"},
+		refName:     recordRefName,
+		key: func(obj object) string {
+			return fmt.Sprintf("%s/%s/%s", strings.ToLower(obj.str("name")), strings.ToLower(obj.str("nameserver")), obj.str("view"))
+		},
+		prepare: prepareNSRecord,
+		service: "DNS",
+	},
+	"record:caa": {
+		basicFields: []string{"ca_flag", "ca_tag", "ca_value", "name", "view"},
+		refName:     recordRefName,
+		key: func(obj object) string {
+			return fmt.Sprintf("%s/%s/%s/%s/%s", strings.ToLower(obj.str("name")), obj.str("ca_flag"), obj.str("ca_tag"),
+				obj.str("ca_value"), obj.str("view"))
+		},
+		prepare: prepareCAARecord,
+		service: "DNS",
+	},
+	"record:naptr": {
+		basicFields: []string{"name", "order", "preference", "regexp", "replacement", "services", "view"},
+		refName:     recordRefName,
+		key: func(obj object) string {
+			return fmt.Sprintf("%s/%s/%s/%s/%s/%s/%s/%s", strings.ToLower(obj.str("name")), obj.str("order"), obj.str("preference"),
+				obj.str("flags"), obj.str("services"), obj.str("regexp"), strings.ToLower(obj.str("replacement")), obj.str("view"))
+		},
+		prepare: prepareNAPTRRecord,
+		service: "DNS",
+	},
+	"record:dname": {
+		basicFields: []string{"name", "target", "view"},
+		refName:     recordRefName,
+		key: func(obj object) string {
+			return strings.ToLower(obj.str("name")) + "/" + obj.str("view")
+		},
+		prepare: prepareDNAMERecord,
+		service: "DNS",
+	},
+	"record:tlsa": {
+		basicFields: []string{"certificate_data", "certificate_usage", "matched_type", "name", "selector", "view"},
+		refName:     recordRefName,
+		key: func(obj object) string {
+			return fmt.Sprintf("%s/%s/%s/%s/%s/%s", strings.ToLower(obj.str("name")), obj.str("certificate_usage"),
+				obj.str("selector"), obj.str("matched_type"), strings.ToLower(obj.str("certificate_data")), obj.str("view"))
+		},
+		prepare: prepareTLSARecord,
+		service: "DNS",
+	},
+	"view": {
+		basicFields: []string{"is_default", "name"},
+		refName:     dnsViewRefName,
+		key:         func(obj object) string { return obj.str("name") },
+		prepare:     prepareDNSView,
+		service:     "DNS",
+	},
+	"networkview": {
+		basicFields: []string{"is_default", "name"},
+		refName:     dnsViewRefName,
+		key:         func(obj object) string { return obj.str("name") },
+		prepare:     prepareNetworkView,
+		expand:      expandNetworkView,
+	},
+	"zone_auth": {
+		basicFields: []string{"fqdn", "view"},
+		refName:     zoneRefName,
+		key:         zoneKey,
+		keySpace:    "zone",
+		prepare:     prepareZoneAuth,
+		service:     "DNS",
+	},
+	"zone_forward": {
+		basicFields: []string{"forward_to", "fqdn", "view"},
+		refName:     zoneRefName,
+		key:         zoneKey,
+		keySpace:    "zone",
+		prepare:     prepareZoneForward,
+		service:     "DNS",
+	},
+	"zone_stub": {
+		basicFields: []string{"fqdn", "stub_from", "view"},
+		refName:     zoneRefName,
+		key:         zoneKey,
+		keySpace:    "zone",
+		prepare:     prepareZoneStub,
+		service:     "DNS",
+	},
+	"zone_delegated": {
+		basicFields: []string{"delegate_to", "fqdn", "view"},
+		refName:     zoneRefName,
+		key:         zoneKey,
+		keySpace:    "zone",
+		prepare:     prepareZoneDelegated,
+		service:     "DNS",
+	},
+	"grid:servicerestart:status": {
+		basicFields: []string{"failures", "finished", "grouped", "needed_restart", "no_restart", "parent", "pending",
+			"pending_restart", "processing", "restarting", "success", "timeouts"},
+		list: listGridRestartStatus,
+	},
+	"grid:servicerestart:request": {
+		basicFields: []string{"error", "forced", "group", "last_updated_time", "member", "needed", "order", "result", "service", "state"},
+		list:        listRestartRequests,
+	},
+	"grid:servicerestart:request:changedobject": {
+		basicFields: []string{"action", "changed_properties", "changed_time", "object_name", "object_type", "user_name"},
+		list:        listChangedObjects,
+	},
+	"restartservicestatus": {
+		basicFields: []string{"dhcp_status", "dns_status", "member", "reporting_status"},
+		list:        listMemberRestartStatus,
+	},
+	"ipv4address": {
+		basicFields: []string{"ip_address", "names", "network", "network_view", "objects", "status", "types", "usage"},
+		refName:     func(obj object) string { return obj.str("ip_address") },
+		list:        listAddresses(true),
+	},
+	"ipv6address": {
+		basicFields: []string{"duid", "ip_address", "names", "network", "network_view", "objects", "status", "types", "usage"},
+		refName:     func(obj object) string { return obj.str("ip_address") },
+		list:        listAddresses(false),
+	},
+}
+
+func networkRefName(obj object) string {
+	return fmt.Sprintf("%s/%s", obj.str("network"), obj.str("network_view"))
+}
+
+func networkKey(obj object) string {
+	return fmt.Sprintf("%s/%s", obj.str("network"), obj.str("network_view"))
+}
+
+func zoneRefName(obj object) string {
+	return fmt.Sprintf("%s/%s", obj.str("fqdn"), obj.str("view"))
+}
+
+func zoneKey(obj object) string {
+	return fmt.Sprintf("%s/%s", strings.ToLower(obj.str("fqdn")), obj.str("view"))
+}
+
+func recordRefName(obj object) string {
+	return fmt.Sprintf("%s/%s", obj.str("name"), obj.str("view"))
+}
+
+func setDefault(obj object, field string, value interface{}) {
+	if _, ok := obj[field]; !ok {
+		obj[field] = value
+	}
+}
+
+// zoneOf returns the most specific authoritative zone containing a fully
+// qualified name in view, or the parent domain when no zone contains it
+func (s *Server) zoneOf(name string, view string) string {
+	zone := ""
+	for _, candidate := range s.objects["zone_auth"] {
+		domain := candidate.str("display_domain")
+		if candidate.str("view") != view || len(domain) <= len(zone) {
+			continue
+		}
+		if strings.EqualFold(name, domain) || strings.HasSuffix(strings.ToLower(name), "."+strings.ToLower(domain)) {
+			zone = domain
+		}
+	}
+	if zone == "" {
+		_, zone, _ = strings.Cut(name, ".")
+	}
+	return zone
+}
+
+func prepareNetwork(objectType string) func(s *Server, obj object, selfRef string) *wapiError {
+	return func(s *Server, obj object, selfRef string) *wapiError {
+		setDefault(obj, "network_view", "default")
+		if function, ok := obj["network"].(map[string]interface{}); ok {
+			values, werr := s.resolveObjectFunction(function)
+			if werr != nil {
+				return werr
+			}
+			obj["network"] = values
+		}
+		network, err := parseNetwork(obj.str("network"))
+		if err != nil || network.v4 == strings.HasPrefix(objectType, "ipv6") {
+			return protoError(http.StatusBadRequest, "Invalid value for network: %s", obj.str("network"))
+		}
+		obj["network"] = network.cidr
+		if !strings.HasSuffix(objectType, "container") {
+			setDefault(obj, "disable", false)
+		}
+		return nil
+	}
+}
+
+func prepareRange(objectType string) func(s *Server, obj object, selfRef string) *wapiError {
+	networkType := "network"
+	if objectType == "ipv6range" {
+		networkType = "ipv6network"
+	}
+	return func(s *Server, obj object, selfRef string) *wapiError {
+		setDefault(obj, "network_view", "default")
+		setDefault(obj, "disable", false)
+		start, end := parseIP(obj.str("start_addr")), parseIP(obj.str("end_addr"))
+		if start == nil || end == nil || start.Cmp(end) > 0 ||
+			strings.Contains(obj.str("start_addr"), ":") != (objectType == "ipv6range") {
+			return protoError(http.StatusBadRequest, "Invalid range %s-%s", obj.str("start_addr"), obj.str("end_addr"))
+		}
+		networkView := obj.str("network_view")
+		if obj.str("network") == "" {
+			parent := s.containingNetwork(networkType, networkView, obj.str("start_addr"))
+			if parent == nil {
+				return dataError("No network found for range %s-%s", obj.str("start_addr"), obj.str("end_addr"))
+			}
+			obj["network"] = parent.str("network")
+		}
+		network, err := parseNetwork(obj.str("network"))
+		if err != nil || !network.contains(start) || !network.contains(end) {
+			return dataError("Range %s-%s is not within network %s", obj.str("start_addr"), obj.str("end_addr"), obj.str("network"))
+		}
+		for _, other := range s.objects[objectType] {
+			if refID(other.ref()) == refID(selfRef) || other.str("network_view") != networkView {
+				continue
+			}
+			otherStart, otherEnd := parseIP(other.str("start_addr")), parseIP(other.str("end_addr"))
+			if start.Cmp(otherEnd) <= 0 && otherStart.Cmp(end) <= 0 {
+				return dataError("Range %s-%s overlaps with range %s-%s", obj.str("start_addr"), obj.str("end_addr"),
+					other.str("start_addr"), other.str("end_addr"))
+			}
+		}
+		return nil
+	}
+}
+
+func prepareFixedAddress(s *Server, obj object, selfRef string) *wapiError {
+	setDefault(obj, "network_view", "default")
+	setDefault(obj, "match_client", "MAC_ADDRESS")
+	if obj.str("match_client") == "RESERVED" {
+		setDefault(obj, "mac", "00:00:00:00:00:00")
+	}
+	address, werr := s.resolveAddress(obj["ipv4addr"], obj.str("network_view"), nil)
+	if werr != nil {
+		return werr
+	}
+	obj["ipv4addr"] = address
+	if network := s.containingNetwork("network", obj.str("network_view"), address); network != nil {
+		obj["network"] = network.str("network")
+	}
+	return nil
+}
+
+func prepareIPv6FixedAddress(s *Server, obj object, selfRef string) *wapiError {
+	setDefault(obj, "network_view", "default")
+	setDefault(obj, "address_type", "ADDRESS")
+	if obj.str("duid") == "" {
+		return protoError(http.StatusBadRequest, "Field duid is required")
+	}
+	address, werr := s.resolveAddress(obj["ipv6addr"], obj.str("network_view"), nil)
+	if werr != nil {
+		return werr
+	}
+	if !strings.Contains(address, ":") {
+		return protoError(http.StatusBadRequest, "Invalid value for ipv6addr: %s", address)
+	}
+	obj["ipv6addr"] = address
+	if network := s.containingNetwork("ipv6network", obj.str("network_view"), address); network != nil {
+		obj["network"] = network.str("network")
+	}
+	return nil
+}
+
+func prepareDNSRecord(s *Server, obj object) {
+	setDefault(obj, "view", "default")
+	setDefault(obj, "disable", false)
+	obj["dns_name"] = obj.str("name")
+	obj["zone"] = s.zoneOf(obj.str("name"), obj.str("view"))
+}
+
+func prepareHostRecord(s *Server, obj object, selfRef string) *wapiError {
+	setDefault(obj, "view", "default")
+	setDefault(obj, "network_view", "default")
+	setDefault(obj, "configure_for_dns", true)
+	obj["zone"] = s.zoneOf(obj.str("name"), obj.str("view"))
+	if obj.str("name") == "" {
+		return protoError(http.StatusBadRequest, "Field name is required")
+	}
+	if werr := s.prepareHostAddresses(obj, "ipv4addrs", "ipv4addr"); werr != nil {
+		return werr
+	}
+	return s.prepareHostAddresses(obj, "ipv6addrs", "ipv6addr")
+}
+
+// prepareHostAddresses resolves the addresses of a host record address list
+func (s *Server) prepareHostAddresses(obj object, listField string, addressField string) *wapiError {
+	name := obj.str("name")
+	addrs, _ := obj[listField].([]interface{})
+	var allocated []string
+	for i, raw := range addrs {
+		addr, ok := raw.(map[string]interface{})
+		if !ok {
+			return protoError(http.StatusBadRequest, "Invalid %s entry", listField)
+		}
+		value := addr[addressField]
+		if function, ok := addr["_object_function"]; ok {
+			value = map[string]interface{}{
+				"_object_function":   function,
+				"_object":            addr["_object"],
+				"_object_parameters": addr["_object_parameters"],
+				"_parameters":        addr["_parameters"],
+				"_result_field":      addr["_result_field"],
+			}
+			for _, field := range []string{"_object_function", "_object", "_object_parameters", "_parameters", "_result_field"} {
+				delete(addr, field)
+			}
+		}
+		address, werr := s.resolveAddress(value, obj.str("network_view"), allocated)
+		if werr != nil {
+			return werr
+		}
+		if strings.Contains(address, ":") != (addressField == "ipv6addr") {
+			return protoError(http.StatusBadRequest, "Invalid value for %s: %s", addressField, address)
+		}
+		allocated = append(allocated, address)
+		addr[addressField] = address
+		addr["host"] = name
+		addr["_ref"] = fmt.Sprintf("record:host_%s/%s:%s/%s/%s", addressField, encodeID("record:host_"+addressField, address),
+			address, name, obj.str("view"))
+		if _, ok := addr["configure_for_dhcp"]; !ok {
+			addr["configure_for_dhcp"] = false
+		}
+		if addressField == "ipv4addr" {
+			if network := s.containingNetwork("network", obj.str("network_view"), address); network != nil {
+				addr["network"] = network.str("network")
+			}
+		} else {
+			setDefault(addr, "address_type", "ADDRESS")
+		}
+		addrs[i] = addr
+	}
+	return nil
+}
+
+func prepareARecord(s *Server, obj object, selfRef string) *wapiError {
+	prepareDNSRecord(s, obj)
+	address, werr := s.resolveAddress(obj["ipv4addr"], s.viewNetworkView(obj.str("view")), nil)
+	if werr != nil {
+		return werr
+	}
+	obj["ipv4addr"] = address
+	return nil
+}
+
+func prepareAAAARecord(s *Server, obj object, selfRef string) *wapiError {
+	prepareDNSRecord(s, obj)
+	address, werr := s.resolveAddress(obj["ipv6addr"], s.viewNetworkView(obj.str("view")), nil)
+	if werr != nil {
+		return werr
+	}
+	if !strings.Contains(address, ":") {
+		return protoError(http.StatusBadRequest, "Invalid value for ipv6addr: %s", address)
+	}
+	obj["ipv6addr"] = address
+	return nil
+}
+
+func prepareCNameRecord(s *Server, obj object, selfRef string) *wapiError {
+	prepareDNSRecord(s, obj)
+	obj["dns_canonical"] = obj.str("canonical")
+	return nil
+}
+
+func preparePtrRecord(s *Server, obj object, selfRef string) *wapiError {
+	setDefault(obj, "view", "default")
+	setDefault(obj, "disable", false)
+	if obj.str("name") == "" {
+		if ip := parseIP(obj.str("ipv4addr")); ip != nil {
+			octets := strings.Split(obj.str("ipv4addr"), ".")
+			for i, j := 0, len(octets)-1; i < j; i, j = i+1, j-1 {
+				octets[i], octets[j] = octets[j], octets[i]
+			}
+			obj["name"] = strings.Join(octets, ".") + ".in-addr.arpa"
+		}
+	}
+	obj["dns_name"] = obj.str("name")
+	obj["dns_ptrdname"] = obj.str("ptrdname")
+	obj["zone"] = s.zoneOf(obj.str("name"), obj.str("view"))
+	return nil
+}
+
+func prepareAliasRecord(s *Server, obj object, selfRef string) *wapiError {
+	prepareDNSRecord(s, obj)
+	obj["dns_target_name"] = obj.str("target_name")
+	return nil
+}
+
+func prepareMXRecord(s *Server, obj object, selfRef string) *wapiError {
+	prepareDNSRecord(s, obj)
+	if obj.str("mail_exchanger") == "" {
+		return protoError(http.StatusBadRequest, "Field mail_exchanger is required")
+	}
+	if werr := requireUint16(obj, "preference"); werr != nil {
+		return werr
+	}
+	obj["dns_mail_exchanger"] = obj.str("mail_exchanger")
+	return nil
+}
+
+// prepareTXTRecord rejects text containing a character string longer than
+// 255 bytes, longer text must be split into quoted character strings
+func prepareTXTRecord(s *Server, obj object, selfRef string) *wapiError {
+	prepareDNSRecord(s, obj)
+	text := obj.str("text")
+	if text == "" {
+		return protoError(http.StatusBadRequest, "Field text is required")
+	}
+	for _, chunk := range txtCharacterStrings(text) {
+		if chunk > 255 {
+			return protoError(http.StatusBadRequest, "Invalid value for text: character string exceeds 255 bytes")
+		}
+	}
+	return nil
+}
+
+// txtCharacterStrings returns the unescaped length of each character string
+// in TXT record text, unquoted text is a single character string
+func txtCharacterStrings(text string) []int {
+	if !strings.HasPrefix(text, `"`) {
+		return []int{len(text)}
+	}
+	var lengths []int
+	quoted, escaped := false, false
+	for i := 0; i < len(text); i++ {
+		switch {
+		case escaped:
+			lengths[len(lengths)-1]++
+			escaped = false
+		case quoted && text[i] == '\\':
+			escaped = true
+		case text[i] == '"':
+			quoted = !quoted
+			if quoted {
+				lengths = append(lengths, 0)
+			}
+		case quoted:
+			lengths[len(lengths)-1]++
+		}
+	}
+	return lengths
+}
+
+func prepareSRVRecord(s *Server, obj object, selfRef string) *wapiError {
+	prepareDNSRecord(s, obj)
+	if obj.str("target") == "" {
+		return protoError(http.StatusBadRequest, "Field target is required")
+	}
+	for _, field := range []string{"priority", "weight", "port"} {
+		if werr := requireUint16(obj, field); werr != nil {
+			return werr
+		}
+	}
+	obj["dns_target"] = obj.str("target")
+	return nil
+}
+
+func prepareNSRecord(s *Server, obj object, selfRef string) *wapiError {
+	setDefault(obj, "view", "default")
+	setDefault(obj, "creator", "STATIC")
+	if obj.str("nameserver") == "" {
+		return protoError(http.StatusBadRequest, "Field nameserver is required")
+	}
+	addresses, _ := obj["addresses"].([]interface{})
+	if len(addresses) == 0 {
+		return protoError(http.StatusBadRequest, "Field addresses is required")
+	}
+	for _, raw := range addresses {
+		address, _ := raw.(map[string]interface{})
+		if parseIP(stringValue(address["address"])) == nil {
+			return protoError(http.StatusBadRequest, "Invalid value for addresses: %s", stringValue(address["address"]))
+		}
+		if _, ok := address["auto_create_ptr"]; !ok {
+			address["auto_create_ptr"] = true
+		}
+	}
+	obj["dns_name"] = obj.str("name")
+	obj["zone"] = obj.str("name")
+	return nil
+}
+
+// requireUint16 checks that a required numeric field holds a 16 bit unsigned value
+func requireUint16(obj object, field string) *wapiError {
+	return requireUintRange(obj, field, 65535)
+}
+
+// requireUintRange checks that a required numeric field is between 0 and max
+func requireUintRange(obj object, field string, max int) *wapiError {
+	if _, ok := obj[field]; !ok {
+		return protoError(http.StatusBadRequest, "Field %s is required", field)
+	}
+	value, err := strconv.Atoi(obj.str(field))
+	if err != nil || value < 0 || value > max {
+		return protoError(http.StatusBadRequest, "Invalid value for %s: %s", field, obj.str(field))
+	}
+	return nil
+}
+
+func prepareCAARecord(s *Server, obj object, selfRef string) *wapiError {
+	prepareDNSRecord(s, obj)
+	setDefault(obj, "ca_flag", 0)
+	for _, field := range []string{"ca_tag", "ca_value"} {
+		if obj.str(field) == "" {
+			return protoError(http.StatusBadRequest, "Field %s is required", field)
+		}
+	}
+	return requireUintRange(obj, "ca_flag", 255)
+}
+
+func prepareNAPTRRecord(s *Server, obj object, selfRef string) *wapiError {
+	prepareDNSRecord(s, obj)
+	setDefault(obj, "flags", "")
+	setDefault(obj, "services", "")
+	setDefault(obj, "regexp", "")
+	if obj.str("replacement") == "" {
+		return protoError(http.StatusBadRequest, "Field replacement is required")
+	}
+	for _, field := range []string{"order", "preference"} {
+		if werr := requireUint16(obj, field); werr != nil {
+			return werr
+		}
+	}
+	obj["dns_replacement"] = obj.str("replacement")
+	return nil
+}
+
+func prepareDNAMERecord(s *Server, obj object, selfRef string) *wapiError {
+	prepareDNSRecord(s, obj)
+	if obj.str("target") == "" {
+		return protoError(http.StatusBadRequest, "Field target is required")
+	}
+	obj["dns_target"] = obj.str("target")
+	return nil
+}
+
+func prepareTLSARecord(s *Server, obj object, selfRef string) *wapiError {
+	prepareDNSRecord(s, obj)
+	if obj.str("certificate_data") == "" {
+		return protoError(http.StatusBadRequest, "Field certificate_data is required")
+	}
+	for field, max := range map[string]int{"certificate_usage": 3, "selector": 1, "matched_type": 2} {
+		if werr := requireUintRange(obj, field, max); werr != nil {
+			return werr
+		}
+	}
+	return nil
+}
+
+// zoneSOADefaults are the grid zone timer defaults applied to new zones
+var zoneSOADefaults = map[string]interface{}{
+	"soa_default_ttl":  28800,
+	"soa_expire":       2419200,
+	"soa_negative_ttl": 900,
+	"soa_refresh":      10800,
+	"soa_retry":        3600,
+}
+
+func prepareZoneAuth(s *Server, obj object, selfRef string) *wapiError {
+	if werr := prepareZone(obj); werr != nil {
+		return werr
+	}
+	setDefault(obj, "use_grid_zone_timer", false)
+	setDefault(obj, "soa_serial_number", 1)
+	setDefault(obj, "soa_email", "hostmaster@"+obj.str("display_domain"))
+	useTimers, _ := obj["use_grid_zone_timer"].(bool)
+	for field, value := range zoneSOADefaults {
+		if !useTimers {
+			obj[field] = value
+		}
+		setDefault(obj, field, value)
+	}
+	for _, field := range []string{"grid_primary", "grid_secondaries"} {
+		if werr := s.prepareZoneMembers(obj, field); werr != nil {
+			return werr
+		}
+	}
+	obj["primary_type"] = "None"
+	if primaries, _ := obj["grid_primary"].([]interface{}); len(primaries) > 0 {
+		obj["primary_type"] = "Grid"
+	}
+	return nil
+}
+
+func prepareZoneForward(s *Server, obj object, selfRef string) *wapiError {
+	if werr := prepareZone(obj); werr != nil {
+		return werr
+	}
+	setDefault(obj, "forwarders_only", false)
+	if obj.str("external_ns_group") == "" {
+		if werr := requireExternalServers(obj, "forward_to"); werr != nil {
+			return werr
+		}
+	}
+	return s.prepareZoneMembers(obj, "forwarding_servers")
+}
+
+func prepareZoneStub(s *Server, obj object, selfRef string) *wapiError {
+	if werr := prepareZone(obj); werr != nil {
+		return werr
+	}
+	if werr := requireExternalServers(obj, "stub_from"); werr != nil {
+		return werr
+	}
+	return s.prepareZoneMembers(obj, "stub_members")
+}
+
+func prepareZoneDelegated(s *Server, obj object, selfRef string) *wapiError {
+	if werr := prepareZone(obj); werr != nil {
+		return werr
+	}
+	setDefault(obj, "use_delegated_ttl", false)
+	setDefault(obj, "delegated_ttl", 28800)
+	if obj.str("ns_group") == "" {
+		return requireExternalServers(obj, "delegate_to")
+	}
+	return nil
+}
+
+// prepareZone validates the zone name against its format and computes the
+// display domain shared by every zone type
+func prepareZone(obj object) *wapiError {
+	setDefault(obj, "view", "default")
+	setDefault(obj, "zone_format", "FORWARD")
+	setDefault(obj, "disable", false)
+	setDefault(obj, "locked", false)
+	fqdn := strings.TrimSuffix(obj.str("fqdn"), ".")
+	if fqdn == "" {
+		return protoError(http.StatusBadRequest, "Field fqdn is required")
+	}
+	displayDomain := fqdn
+	switch obj.str("zone_format") {
+	case "FORWARD":
+		if strings.Contains(fqdn, "/") {
+			return protoError(http.StatusBadRequest, "Invalid value for fqdn: %s", fqdn)
+		}
+	case "IPV4", "IPV6":
+		network, err := parseNetwork(fqdn)
+		if err != nil || network.v4 != (obj.str("zone_format") == "IPV4") {
+			return protoError(http.StatusBadRequest, "Invalid value for fqdn: %s", fqdn)
+		}
+		fqdn = network.cidr
+		displayDomain = reverseZoneName(network)
+	default:
+		return protoError(http.StatusBadRequest, "Invalid value for zone_format: %s", obj.str("zone_format"))
+	}
+	obj["fqdn"] = fqdn
+	obj["dns_fqdn"] = displayDomain
+	obj["display_domain"] = displayDomain
+	return nil
+}
+
+// prepareZoneMembers checks that the member servers in field exist and fills
+// their defaults
+func (s *Server) prepareZoneMembers(obj object, field string) *wapiError {
+	var hostnames []string
+	for _, member := range s.objects["member"] {
+		hostnames = append(hostnames, member.str("host_name"))
+	}
+	servers, _ := obj[field].([]interface{})
+	for _, raw := range servers {
+		server, _ := raw.(map[string]interface{})
+		if !containsString(hostnames, stringValue(server["name"])) {
+			return dataError("Member %s not found", stringValue(server["name"]))
+		}
+		delete(server, "_struct")
+		flags := []string{"stealth", "grid_replicate", "lead"}
+		if field == "forwarding_servers" {
+			flags = []string{"forwarders_only", "use_override_forwarders"}
+		}
+		for _, flag := range flags {
+			if _, ok := server[flag]; !ok {
+				server[flag] = false
+			}
+		}
+	}
+	return nil
+}
+
+// requireExternalServers checks that field holds at least one external
+// server with a name and valid address
+func requireExternalServers(obj object, field string) *wapiError {
+	servers, _ := obj[field].([]interface{})
+	if len(servers) == 0 {
+		return protoError(http.StatusBadRequest, "Field %s is required", field)
+	}
+	for _, raw := range servers {
+		server, _ := raw.(map[string]interface{})
+		if stringValue(server["name"]) == "" || parseIP(stringValue(server["address"])) == nil {
+			return protoError(http.StatusBadRequest, "Invalid value for %s: name and address are required", field)
+		}
+		if _, ok := server["stealth"]; !ok {
+			server["stealth"] = false
+		}
+	}
+	return nil
+}
+
+// reverseZoneName returns the in-addr.arpa or ip6.arpa name of a reverse zone
+func reverseZoneName(network *ipNetwork) string {
+	var labels []string
+	if network.v4 {
+		octets := strings.Split(formatIP(network.first, true), ".")
+		for i := network.prefix / 8; i > 0; i-- {
+			labels = append(labels, octets[i-1])
+		}
+		return strings.Join(append(labels, "in-addr", "arpa"), ".")
+	}
+	digits := fmt.Sprintf("%032x", network.first)
+	for i := network.prefix / 4; i > 0; i-- {
+		labels = append(labels, digits[i-1:i])
+	}
+	return strings.Join(append(labels, "ip6", "arpa"), ".")
+}
+
+func dnsViewRefName(obj object) string {
+	return fmt.Sprintf("%s/%t", obj.str("name"), obj["is_default"] == true)
+}
+
+func prepareDNSView(s *Server, obj object, selfRef string) *wapiError {
+	setDefault(obj, "network_view", "default")
+	setDefault(obj, "disable", false)
+	setDefault(obj, "recursion", false)
+	setDefault(obj, "is_default", false)
+	if obj.str("name") == "" {
+		return protoError(http.StatusBadRequest, "Field name is required")
+	}
+	for _, networkView := range s.objects["networkview"] {
+		if networkView.str("name") == obj.str("network_view") {
+			return nil
+		}
+	}
+	return dataError("Network view %s not found", obj.str("network_view"))
+}
+
+func prepareNetworkView(s *Server, obj object, selfRef string) *wapiError {
+	setDefault(obj, "is_default", false)
+	delete(obj, "associated_dns_views")
+	if obj.str("name") == "" {
+		return protoError(http.StatusBadRequest, "Field name is required")
+	}
+	return nil
+}
+
+// expandNetworkView lists the DNS views associated with a network view
+func expandNetworkView(s *Server, obj object) {
+	views := []interface{}{}
+	for _, view := range s.objects["view"] {
+		if view.str("network_view") == obj.str("name") {
+			views = append(views, view.str("name"))
+		}
+	}
+	obj["associated_dns_views"] = views
+}
+
+// viewNetworkView returns the network view associated with a DNS view
+func (s *Server) viewNetworkView(name string) string {
+	for _, view := range s.objects["view"] {
+		if view.str("name") == name {
+			return view.str("network_view")
+		}
+	}
+	return "default"
+}
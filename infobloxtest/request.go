@@ -0,0 +1,157 @@
+package infobloxtest
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// requestObject is the WAPI object executing several operations in one
+// transaction
+const requestObject = "request"
+
+// snapshot is the stored state restored when a multi-object request fails
+type snapshot struct {
+	counter int
+	objects map[string][]object
+	changes []object
+	pending map[string]map[string]bool
+}
+
+func (s *Server) snapshot() snapshot {
+	ret := snapshot{
+		counter: s.counter,
+		objects: map[string][]object{},
+		changes: append([]object(nil), s.changes...),
+		pending: map[string]map[string]bool{},
+	}
+	for objectType, objects := range s.objects {
+		for _, obj := range objects {
+			ret.objects[objectType] = append(ret.objects[objectType], obj.clone())
+		}
+	}
+	for hostname, services := range s.pending {
+		ret.pending[hostname] = map[string]bool{}
+		for service := range services {
+			ret.pending[hostname][service] = true
+		}
+	}
+	return ret
+}
+
+func (s *Server) restore(snap snapshot) {
+	s.counter = snap.counter
+	s.objects = snap.objects
+	s.changes = snap.changes
+	s.pending = snap.pending
+}
+
+// multiRequest executes the operations of a request object in order,
+// substituting state assigned by earlier operations.  Every change is rolled
+// back when an operation fails
+func (s *Server) multiRequest(method string, body interface{}) (interface{}, *wapiError) {
+	if method != http.MethodPost {
+		return nil, protoError(http.StatusBadRequest, "request requires POST")
+	}
+	operations, ok := body.([]interface{})
+	if !ok {
+		return nil, protoError(http.StatusBadRequest, "request requires a list of operations")
+	}
+
+	snap := s.snapshot()
+	state := map[string]interface{}{}
+	results := []interface{}{}
+	for i, item := range operations {
+		operation, ok := item.(map[string]interface{})
+		if !ok {
+			s.restore(snap)
+			return nil, protoError(http.StatusBadRequest, "Operation %d is not an object", i)
+		}
+		if substitute, _ := operation["enable_substitution"].(bool); substitute {
+			operation = substituteState(operation, state).(map[string]interface{})
+		}
+		operationMethod, _ := operation["method"].(string)
+		target, _ := operation["object"].(string)
+		data, _ := operation["data"].(map[string]interface{})
+		query := url.Values{}
+		if args, ok := operation["args"].(map[string]interface{}); ok {
+			for key, value := range args {
+				query.Set(key, stringValue(value))
+			}
+		}
+		if operationMethod == http.MethodGet {
+			for key, value := range data {
+				query.Set(key, stringValue(value))
+			}
+			data = nil
+		}
+
+		result, werr := s.dispatch(operationMethod, target, query, object(data))
+		if werr != nil {
+			s.restore(snap)
+			werr.Error = fmt.Sprintf("%s (operation %d)", werr.Error, i)
+			return nil, werr
+		}
+		if assign, ok := operation["assign_state"].(map[string]interface{}); ok {
+			for name, field := range assign {
+				state[name] = resultField(result, stringValue(field))
+			}
+		}
+		if discard, _ := operation["discard"].(bool); !discard {
+			results = append(results, result)
+		}
+	}
+	return results, nil
+}
+
+// substituteState replaces ##STATE:name:## placeholders in every string of value
+func substituteState(value interface{}, state map[string]interface{}) interface{} {
+	switch v := value.(type) {
+	case string:
+		for name, assigned := range state {
+			placeholder := "##STATE:" + name + ":##"
+			if v == placeholder {
+				return assigned
+			}
+			v = strings.ReplaceAll(v, placeholder, stringValue(assigned))
+		}
+		return v
+	case map[string]interface{}:
+		ret := map[string]interface{}{}
+		for key, item := range v {
+			ret[key] = substituteState(item, state)
+		}
+		return ret
+	case []interface{}:
+		ret := []interface{}{}
+		for _, item := range v {
+			ret = append(ret, substituteState(item, state))
+		}
+		return ret
+	}
+	return value
+}
+
+// resultField returns field of an operation result, using the first object of
+// search results and the reference returned by creates
+func resultField(result interface{}, field string) interface{} {
+	switch v := result.(type) {
+	case string:
+		if field == "_ref" {
+			return v
+		}
+	case object:
+		return v[field]
+	case map[string]interface{}:
+		if results, ok := v["result"]; ok {
+			return resultField(results, field)
+		}
+		return v[field]
+	case []interface{}:
+		if len(v) > 0 {
+			return resultField(v[0], field)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,217 @@
+package infobloxtest
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"time"
+)
+
+var (
+	restartOptions      = []string{"FORCE_RESTART", "RESTART_IF_NEEDED"}
+	restartServiceNames = []string{"ALL", "DNS", "DHCP", "DHCPV4", "DHCPV6"}
+	restartModes        = []string{"GROUPED", "SEQUENTIAL", "SIMULTANEOUS"}
+)
+
+// restartServices validates and records a grid restartservices call
+func (s *Server) restartServices(body object) (interface{}, *wapiError) {
+	if body == nil {
+		body = object{}
+	}
+	if option := body.str("restart_option"); option != "" && !containsString(restartOptions, option) {
+		return nil, protoError(http.StatusBadRequest, "Invalid value for restart_option: %s", option)
+	}
+	if mode := body.str("mode"); mode != "" && !containsString(restartModes, mode) {
+		return nil, protoError(http.StatusBadRequest, "Invalid value for mode: %s", mode)
+	}
+	for _, service := range stringListParameter(body, "services") {
+		if !containsString(restartServiceNames, service) {
+			return nil, protoError(http.StatusBadRequest, "Invalid value for services: %s", service)
+		}
+	}
+	var hostnames []string
+	for _, member := range s.objects["member"] {
+		hostnames = append(hostnames, member.str("host_name"))
+	}
+	for _, member := range stringListParameter(body, "members") {
+		if !containsString(hostnames, member) {
+			return nil, dataError("Member %s not found", member)
+		}
+	}
+	s.restarts = append(s.restarts, body.clone())
+	s.restartPending = s.RestartPolls
+	s.clearPending(stringListParameter(body, "members"), stringListParameter(body, "services"))
+	return map[string]interface{}{}, nil
+}
+
+// recordChange marks every member as needing a restart of the service
+// affected by a change unless the change requested an automatic restart
+func (s *Server) recordChange(objectType string, obj object, action string, body object) {
+	service := objectTypes[objectType].service
+	if service == "" {
+		return
+	}
+	if restart, ok := body["restart_if_needed"].(bool); ok && restart {
+		return
+	}
+	var properties []interface{}
+	var fields []string
+	for field := range body {
+		if field != "_ref" && field != "restart_if_needed" {
+			fields = append(fields, field)
+		}
+	}
+	sort.Strings(fields)
+	for _, field := range fields {
+		properties = append(properties, field)
+	}
+	name := obj.str("name")
+	if name == "" {
+		name = objectTypes[objectType].refName(obj)
+	}
+	userName := s.Username
+	if userName == "" {
+		userName = "admin"
+	}
+	s.changes = append(s.changes, object{
+		"_ref":               fmt.Sprintf("grid:servicerestart:request:changedobject/%s:%s", encodeID("changedobject", len(s.changes)), name),
+		"action":             action,
+		"changed_properties": nonNil(properties),
+		"changed_time":       time.Now().Unix(),
+		"object_name":        name,
+		"object_type":        objectType,
+		"user_name":          userName,
+	})
+	for _, member := range s.objects["member"] {
+		hostname := member.str("host_name")
+		if s.pending[hostname] == nil {
+			s.pending[hostname] = map[string]bool{}
+		}
+		s.pending[hostname][service] = true
+	}
+}
+
+// clearPending clears pending restarts of the supplied members and services,
+// an empty list selects all of them
+func (s *Server) clearPending(members []string, services []string) {
+	for hostname, pending := range s.pending {
+		if len(members) > 0 && !containsString(members, hostname) {
+			continue
+		}
+		for service := range pending {
+			if len(services) == 0 || containsString(services, "ALL") || containsString(services, service) ||
+				(service == "DHCP" && containsString(services, "DHCPV4")) ||
+				(service == "DHCPV6" && containsString(services, "DHCP")) {
+				delete(pending, service)
+			}
+		}
+		if len(pending) == 0 {
+			delete(s.pending, hostname)
+		}
+	}
+	if len(s.pending) == 0 {
+		s.changes = nil
+	}
+}
+
+// listRestartRequests computes a grid:servicerestart:request object for
+// every member and service awaiting a restart
+func listRestartRequests(s *Server, query url.Values) ([]object, *wapiError) {
+	var hostnames []string
+	for hostname := range s.pending {
+		hostnames = append(hostnames, hostname)
+	}
+	sort.Strings(hostnames)
+	var ret []object
+	for _, hostname := range hostnames {
+		var services []string
+		for service := range s.pending[hostname] {
+			services = append(services, service)
+		}
+		sort.Strings(services)
+		for _, service := range services {
+			ret = append(ret, object{
+				"_ref":              fmt.Sprintf("grid:servicerestart:request/%s:%s/%s", encodeID("request", hostname+service), hostname, service),
+				"error":             "",
+				"forced":            false,
+				"group":             "",
+				"last_updated_time": time.Now().Unix(),
+				"member":            hostname,
+				"needed":            "NEEDED",
+				"order":             0,
+				"result":            "",
+				"service":           service,
+				"state":             "PENDING",
+			})
+		}
+	}
+	return ret, nil
+}
+
+// listChangedObjects returns the changes awaiting a service restart
+func listChangedObjects(s *Server, query url.Values) ([]object, *wapiError) {
+	var ret []object
+	for _, change := range s.changes {
+		ret = append(ret, change.clone())
+	}
+	return ret, nil
+}
+
+// listGridRestartStatus computes the grid:servicerestart:status object,
+// advancing any in progress restart by one poll
+func listGridRestartStatus(s *Server, query url.Values) ([]object, *wapiError) {
+	grid := s.objects["grid"][0]
+	members := len(s.objects["member"])
+	obj := object{
+		"_ref":            fmt.Sprintf("grid:servicerestart:status/%s:%s", encodeID("grid:servicerestart:status", grid.str("name")), grid.str("name")),
+		"parent":          grid.ref(),
+		"grouped":         "GRID",
+		"failures":        0,
+		"finished":        members,
+		"needed_restart":  len(s.pending),
+		"no_restart":      0,
+		"pending":         0,
+		"pending_restart": 0,
+		"processing":      0,
+		"restarting":      0,
+		"success":         members,
+		"timeouts":        0,
+	}
+	if s.restartPending > 0 {
+		s.restartPending--
+		obj["finished"] = 0
+		obj["success"] = 0
+		obj["restarting"] = members
+	}
+	return []object{obj}, nil
+}
+
+// listMemberRestartStatus computes a restartservicestatus object per member
+func listMemberRestartStatus(s *Server, query url.Values) ([]object, *wapiError) {
+	status := "WORKING"
+	if s.restartPending > 0 {
+		status = "RESTARTING"
+	}
+	var ret []object
+	for _, member := range s.objects["member"] {
+		name := member.str("host_name")
+		ret = append(ret, object{
+			"_ref":             fmt.Sprintf("restartservicestatus/%s:%s", encodeID("restartservicestatus", name), name),
+			"member":           name,
+			"dhcp_status":      status,
+			"dns_status":       status,
+			"reporting_status": status,
+		})
+	}
+	return ret, nil
+}
+
+func containsString(list []string, value string) bool {
+	for _, item := range list {
+		if item == value {
+			return true
+		}
+	}
+	return false
+}
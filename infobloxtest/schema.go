@@ -0,0 +1,105 @@
+package infobloxtest
+
+import (
+	"net/http"
+	"sort"
+	"strings"
+)
+
+// SupportedVersions are the WAPI versions the fake server accepts and
+// reports in its schema by default
+var SupportedVersions = []string{"1.0", "1.7", "2.0", "2.5", "2.7", "2.9", "2.10", Version}
+
+// SchemaField describes a field of an object schema
+type SchemaField struct {
+	Name          string   `json:"name"`
+	Type          []string `json:"type"`
+	IsArray       bool     `json:"is_array"`
+	Supports      string   `json:"supports"`
+	SearchableBy  string   `json:"searchable_by"`
+	StandardField bool     `json:"standard_field"`
+}
+
+// SetSchema replaces the fields reported by the schema of an object type.
+// Object types without a schema report their basic fields and the fields of
+// their stored objects as readable, writable and searchable strings
+func (s *Server) SetSchema(objectType string, fields ...SchemaField) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.schemas == nil {
+		s.schemas = map[string][]SchemaField{}
+	}
+	s.schemas[objectType] = fields
+}
+
+// supportsVersion reports whether requests for the WAPI version are accepted
+func (s *Server) supportsVersion(version string) bool {
+	for _, v := range s.versions() {
+		if v == version {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *Server) versions() []string {
+	if s.Versions != nil {
+		return s.Versions
+	}
+	return SupportedVersions
+}
+
+// schema renders the grid schema when target is empty and the schema of an
+// object type otherwise
+func (s *Server) schema(version string, target string) (interface{}, *wapiError) {
+	if target == "" {
+		var objects []string
+		for name := range objectTypes {
+			objects = append(objects, name)
+		}
+		sort.Strings(objects)
+		return map[string]interface{}{
+			"requested_version":         version,
+			"supported_versions":        s.versions(),
+			"supported_objects":         objects,
+			"supported_schema_versions": []string{"1", "2"},
+		}, nil
+	}
+
+	objectType, _ := splitTarget(target)
+	definition, ok := objectTypes[objectType]
+	if !ok {
+		return nil, protoError(http.StatusBadRequest, "Unknown object type (%s)", objectType)
+	}
+	fields, ok := s.schemas[objectType]
+	if !ok {
+		names := map[string]bool{}
+		for _, name := range definition.basicFields {
+			names[name] = true
+		}
+		for _, obj := range s.objects[objectType] {
+			for name := range obj {
+				if !strings.HasPrefix(name, "_") {
+					names[name] = true
+				}
+			}
+		}
+		for name := range names {
+			fields = append(fields, SchemaField{
+				Name:          name,
+				Type:          []string{"string"},
+				Supports:      "rwus",
+				SearchableBy:  "=",
+				StandardField: true,
+			})
+		}
+		sort.Slice(fields, func(i, j int) bool { return fields[i].Name < fields[j].Name })
+	}
+	return map[string]interface{}{
+		"type":                          objectType,
+		"version":                       version,
+		"fields":                        fields,
+		"restrictions":                  []string{},
+		"cloud_additional_restrictions": []string{},
+	}, nil
+}
@@ -0,0 +1,381 @@
+// Package infobloxtest provides an in-process fake of the Infoblox WAPI
+// for exercising the sdk without a grid.
+//
+// The fake keeps every object in memory as decoded JSON, generates _ref
+// strings, honors _return_fields, _return_fields+, _return_as_object,
+// _paging/_page_id/_max_results and WAPI search modifiers, computes
+// ipv4address and ipv6address status from the stored objects, implements the
+// next_available_network, next_available_ip and restartservices functions,
+// executes multi-object request bodies in a single transaction and reports
+// the grid and object schemas requested with _schema.
+package infobloxtest
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync"
+)
+
+const (
+	// Version is the WAPI version reported by the fake server
+	Version = "2.11"
+	// AuthCookie is the name of the session cookie issued by the fake server
+	AuthCookie = "ibapauth"
+)
+
+// Server is an in-memory fake WAPI endpoint
+type Server struct {
+	*httptest.Server
+	// Username and Password, when set, are required through basic auth
+	// unless a valid session cookie is presented
+	Username string
+	Password string
+	// RestartPolls is the number of restart status queries a service
+	// restart remains in progress for
+	RestartPolls int
+	// Versions, when set, replaces SupportedVersions as the WAPI versions
+	// accepted by the server
+	Versions []string
+
+	mu             sync.Mutex
+	counter        int
+	objects        map[string][]object
+	sessions       map[string]bool
+	requests       []string
+	restarts       []object
+	restartPending int
+	changes        []object
+	pending        map[string]map[string]bool
+	schemas        map[string][]SchemaField
+}
+
+// NewServer starts a TLS fake WAPI server seeded with a grid, a member and
+// the default DNS and network views
+func NewServer() *Server {
+	s := &Server{}
+	s.Reset()
+	s.Server = httptest.NewTLSServer(s)
+	return s
+}
+
+// Reset removes every stored object and session and reseeds the grid
+func (s *Server) Reset() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.counter = 0
+	s.objects = map[string][]object{}
+	s.sessions = map[string]bool{}
+	s.requests = nil
+	s.restarts = nil
+	s.restartPending = 0
+	s.changes = nil
+	s.pending = map[string]map[string]bool{}
+	s.insert("grid", object{"name": "Infoblox", "service_status": "WORKING"})
+	s.insert("networkview", object{"name": "default", "is_default": true})
+	s.insert("view", object{"name": "default", "network_view": "default", "is_default": true,
+		"disable": false, "recursion": false})
+	s.insert("member", object{
+		"host_name":                  "infoblox.localdomain",
+		"config_addr_type":           "IPV4",
+		"platform":                   "VNIOS",
+		"service_type_configuration": "ALL_V4",
+	})
+}
+
+// Host returns the host portion of the server address
+func (s *Server) Host() string {
+	host, _, _ := net.SplitHostPort(strings.TrimPrefix(s.URL, "https://"))
+	return host
+}
+
+// Port returns the port portion of the server address
+func (s *Server) Port() string {
+	_, port, _ := net.SplitHostPort(strings.TrimPrefix(s.URL, "https://"))
+	return port
+}
+
+// Add stores an object of the supplied type and returns its reference
+func (s *Server) Add(objectType string, fields map[string]interface{}) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	obj, err := normalize(fields)
+	if err != nil {
+		return "", err
+	}
+	created, werr := s.create(objectType, obj)
+	if werr != nil {
+		return "", fmt.Errorf("%s", werr.Error)
+	}
+	return created.ref(), nil
+}
+
+// Objects returns copies of all stored objects of the supplied type
+func (s *Server) Objects(objectType string) []map[string]interface{} {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var ret []map[string]interface{}
+	for _, obj := range s.objects[objectType] {
+		ret = append(ret, obj.clone())
+	}
+	return ret
+}
+
+// Requests returns the method and path of every request received
+func (s *Server) Requests() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]string(nil), s.requests...)
+}
+
+// Restarts returns the body of every restartservices call received
+func (s *Server) Restarts() []map[string]interface{} {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var ret []map[string]interface{}
+	for _, restart := range s.restarts {
+		ret = append(ret, restart.clone())
+	}
+	return ret
+}
+
+// ServeHTTP implements http.Handler
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.requests = append(s.requests, r.Method+" "+r.URL.RequestURI())
+
+	if !s.authenticate(w, r) {
+		writeError(w, &wapiError{
+			status: http.StatusUnauthorized,
+			Error:  "AdmConProtoError: Authorization Required",
+			Code:   "Client.Ibap.Proto",
+			Text:   "Authorization Required",
+		})
+		return
+	}
+
+	path := strings.TrimPrefix(r.URL.EscapedPath(), "/")
+	parts := strings.SplitN(path, "/", 3)
+	if len(parts) < 3 || parts[0] != "wapi" || !strings.HasPrefix(parts[1], "v") {
+		writeError(w, protoError(http.StatusNotFound, "Unknown WAPI path %s", r.URL.Path))
+		return
+	}
+	target, err := url.PathUnescape(parts[2])
+	if err != nil {
+		writeError(w, protoError(http.StatusBadRequest, "Invalid path %s", parts[2]))
+		return
+	}
+	query := r.URL.Query()
+	version := strings.TrimPrefix(parts[1], "v")
+	if !s.supportsVersion(version) {
+		writeError(w, protoError(http.StatusBadRequest, "Version %s not supported", version))
+		return
+	}
+
+	var body object
+	var raw interface{}
+	if r.Body != nil && r.ContentLength != 0 {
+		decoder := json.NewDecoder(r.Body)
+		decoder.UseNumber()
+		if err := decoder.Decode(&raw); err == nil {
+			if m, ok := raw.(map[string]interface{}); ok {
+				body = object(m)
+			}
+		}
+	}
+
+	var result interface{}
+	var werr *wapiError
+	if _, ok := query["_schema"]; ok && r.Method == http.MethodGet {
+		result, werr = s.schema(version, target)
+	} else if target == requestObject {
+		result, werr = s.multiRequest(r.Method, raw)
+	} else {
+		result, werr = s.dispatch(r.Method, target, query, body)
+	}
+	if werr != nil {
+		writeError(w, werr)
+		return
+	}
+	if result == nil {
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	if r.Method == http.MethodPost && query.Get("_function") == "" && target != "logout" && target != requestObject {
+		w.WriteHeader(http.StatusCreated)
+	}
+	json.NewEncoder(w).Encode(result)
+}
+
+func (s *Server) authenticate(w http.ResponseWriter, r *http.Request) bool {
+	if s.Username == "" && s.Password == "" {
+		return true
+	}
+	if cookie, err := r.Cookie(AuthCookie); err == nil && s.sessions[cookie.Value] {
+		return true
+	}
+	// Clients presenting a certificate verified by the TLS listener are
+	// authenticated without credentials
+	username, password, ok := r.BasicAuth()
+	if !ok && r.TLS != nil && len(r.TLS.VerifiedChains) > 0 {
+		ok, username, password = true, s.Username, s.Password
+	}
+	if !ok || username != s.Username || password != s.Password {
+		return false
+	}
+	token := make([]byte, 16)
+	rand.Read(token)
+	session := hex.EncodeToString(token)
+	s.sessions[session] = true
+	http.SetCookie(w, &http.Cookie{Name: AuthCookie, Value: session, Path: "/", Secure: true, HttpOnly: true})
+	return true
+}
+
+// ExpireSessions invalidates every issued session cookie
+func (s *Server) ExpireSessions() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.sessions = map[string]bool{}
+}
+
+func (s *Server) dispatch(method string, target string, query url.Values, body object) (interface{}, *wapiError) {
+	if target == "logout" {
+		if method != http.MethodPost {
+			return nil, protoError(http.StatusBadRequest, "logout requires POST")
+		}
+		return nil, nil
+	}
+
+	objectType, isRef := splitTarget(target)
+	if _, ok := objectTypes[objectType]; !ok {
+		return nil, protoError(http.StatusBadRequest, "Unknown object type (%s)", objectType)
+	}
+
+	switch method {
+	case http.MethodGet:
+		if isRef {
+			obj, werr := s.lookup(target)
+			if werr != nil {
+				return nil, werr
+			}
+			return s.project(objectType, obj, query), nil
+		}
+		return s.search(objectType, query)
+	case http.MethodPost:
+		if function := query.Get("_function"); function != "" {
+			return s.callFunction(objectType, target, isRef, function, body)
+		}
+		if isRef {
+			return nil, protoError(http.StatusBadRequest, "POST requires an object type")
+		}
+		created, werr := s.create(objectType, body)
+		if werr != nil {
+			return nil, werr
+		}
+		s.recordChange(objectType, created, "ADD", body)
+		return s.writeResult(objectType, created, query), nil
+	case http.MethodPut:
+		if !isRef {
+			return nil, protoError(http.StatusBadRequest, "PUT requires an object reference")
+		}
+		updated, werr := s.update(target, body)
+		if werr != nil {
+			return nil, werr
+		}
+		s.recordChange(objectType, updated, "MODIFY", body)
+		return s.writeResult(objectType, updated, query), nil
+	case http.MethodDelete:
+		if !isRef {
+			return nil, protoError(http.StatusBadRequest, "DELETE requires an object reference")
+		}
+		deleted, werr := s.delete(target)
+		if werr != nil {
+			return nil, werr
+		}
+		s.recordChange(objectType, deleted, "DELETE", nil)
+		return deleted.ref(), nil
+	}
+	return nil, protoError(http.StatusMethodNotAllowed, "Unsupported method %s", method)
+}
+
+// writeResult renders the response of a create or update call
+func (s *Server) writeResult(objectType string, obj object, query url.Values) interface{} {
+	var ret interface{} = obj.ref()
+	if query.Get("_return_fields") != "" || query.Get("_return_fields+") != "" {
+		ret = s.project(objectType, obj, query)
+	}
+	if query.Get("_return_as_object") == "1" {
+		return map[string]interface{}{"result": ret}
+	}
+	return ret
+}
+
+// splitTarget splits a request target into object type and whether it is a reference
+func splitTarget(target string) (string, bool) {
+	if i := strings.Index(target, "/"); i >= 0 {
+		return target[:i], true
+	}
+	return target, false
+}
+
+// wapiError mirrors the error body returned by WAPI
+type wapiError struct {
+	status int
+	Error  string `json:"Error"`
+	Code   string `json:"code"`
+	Text   string `json:"text"`
+}
+
+func writeError(w http.ResponseWriter, werr *wapiError) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(werr.status)
+	json.NewEncoder(w).Encode(werr)
+}
+
+func protoError(status int, format string, args ...interface{}) *wapiError {
+	text := fmt.Sprintf(format, args...)
+	return &wapiError{
+		status: status,
+		Error:  "AdmConProtoError: " + text,
+		Code:   "Client.Ibap.Proto",
+		Text:   text,
+	}
+}
+
+func notFoundError(ref string) *wapiError {
+	text := fmt.Sprintf("Reference %s not found", ref)
+	return &wapiError{
+		status: http.StatusNotFound,
+		Error:  "AdmConDataNotFoundError: " + text,
+		Code:   "Client.Ibap.Data.NotFound",
+		Text:   text,
+	}
+}
+
+func conflictError(objectType string, key string) *wapiError {
+	text := fmt.Sprintf("The %s %s already exists.", objectType, key)
+	return &wapiError{
+		status: http.StatusBadRequest,
+		Error:  "AdmConDataError: None (IBDataConflictError: IB.Data.Conflict:" + text + ")",
+		Code:   "Client.Ibap.Data.Conflict",
+		Text:   text,
+	}
+}
+
+func dataError(format string, args ...interface{}) *wapiError {
+	text := fmt.Sprintf(format, args...)
+	return &wapiError{
+		status: http.StatusBadRequest,
+		Error:  "AdmConDataError: None (IBDataError: IB.Data:" + text + ")",
+		Code:   "Client.Ibap.Data",
+		Text:   text,
+	}
+}
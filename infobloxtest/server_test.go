@@ -0,0 +1,264 @@
+package infobloxtest
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"testing"
+)
+
+func doRequest(t *testing.T, server *Server, method string, path string, params url.Values, body interface{}, result interface{}) int {
+	t.Helper()
+	var buf bytes.Buffer
+	if body != nil {
+		json.NewEncoder(&buf).Encode(body)
+	}
+	target := fmt.Sprintf("%s/wapi/v%s/%s", server.URL, Version, path)
+	if len(params) > 0 {
+		target += "?" + params.Encode()
+	}
+	request, err := http.NewRequest(method, target, &buf)
+	if err != nil {
+		t.Fatalf("Error creating request: %s", err)
+	}
+	response, err := server.Client().Do(request)
+	if err != nil {
+		t.Fatalf("Error sending request: %s", err)
+	}
+	defer response.Body.Close()
+	if result != nil {
+		json.NewDecoder(response.Body).Decode(result)
+	}
+	return response.StatusCode
+}
+
+func TestCreateAndSearch(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+
+	for i := 0; i < 5; i++ {
+		var ref string
+		status := doRequest(t, server, http.MethodPost, "network", nil, map[string]interface{}{
+			"network": fmt.Sprintf("10.0.%d.0/24", i),
+			"comment": fmt.Sprintf("Network %d", i),
+			"extattrs": map[string]interface{}{
+				"Site": map[string]interface{}{"value": fmt.Sprintf("site-%d", i%2)},
+			},
+		}, &ref)
+		if status != http.StatusCreated || ref == "" {
+			t.Fatalf("Unexpected create response %d %q", status, ref)
+		}
+	}
+
+	var duplicate map[string]interface{}
+	status := doRequest(t, server, http.MethodPost, "network", nil, map[string]interface{}{"network": "10.0.0.0/24"}, &duplicate)
+	if status != http.StatusBadRequest || duplicate["code"] != "Client.Ibap.Data.Conflict" {
+		t.Errorf("Expected conflict creating duplicate network, got %d %v", status, duplicate)
+	}
+
+	var results []map[string]interface{}
+	doRequest(t, server, http.MethodGet, "network", url.Values{"*Site": {"site-1"}}, nil, &results)
+	if len(results) != 2 {
+		t.Errorf("Expected 2 networks matching EA search, got %d", len(results))
+	}
+
+	results = nil
+	doRequest(t, server, http.MethodGet, "network", url.Values{"comment~:": {"^NETWORK [34]$"}}, nil, &results)
+	if len(results) != 2 {
+		t.Errorf("Expected 2 networks matching regex search, got %d", len(results))
+	}
+
+	results = nil
+	doRequest(t, server, http.MethodGet, "network", url.Values{
+		"network!":       {"10.0.0.0/24"},
+		"_return_fields": {"network,extattrs"},
+	}, nil, &results)
+	if len(results) != 4 {
+		t.Fatalf("Expected 4 networks matching negated search, got %d", len(results))
+	}
+	if _, ok := results[0]["comment"]; ok {
+		t.Errorf("Expected comment to be excluded by _return_fields")
+	}
+	if _, ok := results[0]["extattrs"]; !ok {
+		t.Errorf("Expected extattrs to be included by _return_fields")
+	}
+}
+
+func TestPaging(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+	for i := 0; i < 5; i++ {
+		if _, err := server.Add("networkcontainer", map[string]interface{}{"network": fmt.Sprintf("10.%d.0.0/16", i)}); err != nil {
+			t.Fatalf("Error seeding container: %s", err)
+		}
+	}
+
+	params := url.Values{
+		"_paging":           {"1"},
+		"_return_as_object": {"1"},
+		"_max_results":      {"2"},
+	}
+	var seen []string
+	for page := 0; page < 5; page++ {
+		var result struct {
+			NextPageID string                   `json:"next_page_id"`
+			Result     []map[string]interface{} `json:"result"`
+		}
+		doRequest(t, server, http.MethodGet, "networkcontainer", params, nil, &result)
+		for _, obj := range result.Result {
+			seen = append(seen, obj["network"].(string))
+		}
+		if result.NextPageID == "" {
+			break
+		}
+		params.Set("_page_id", result.NextPageID)
+	}
+	if len(seen) != 5 {
+		t.Errorf("Expected 5 containers across pages, got %v", seen)
+	}
+}
+
+func TestIPv4AddressStatus(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+	server.Add("network", map[string]interface{}{"network": "192.168.1.0/24"})
+	server.Add("record:host", map[string]interface{}{
+		"name":      "host.example.com",
+		"ipv4addrs": []interface{}{map[string]interface{}{"ipv4addr": "func:nextavailableip:192.168.1.0/24"}},
+	})
+
+	var result struct {
+		Result []map[string]interface{} `json:"result"`
+	}
+	doRequest(t, server, http.MethodGet, "ipv4address", url.Values{
+		"network":           {"192.168.1.0/24"},
+		"status":            {"USED"},
+		"_return_as_object": {"1"},
+	}, nil, &result)
+	if len(result.Result) != 3 {
+		t.Fatalf("Expected network, broadcast and host addresses to be used, got %d", len(result.Result))
+	}
+	if result.Result[1]["ip_address"] != "192.168.1.1" {
+		t.Errorf("Expected host to be allocated 192.168.1.1, got %v", result.Result[1]["ip_address"])
+	}
+
+	result.Result = nil
+	doRequest(t, server, http.MethodGet, "ipv4address", url.Values{
+		"network":           {"192.168.1.0/24"},
+		"status":            {"UNUSED"},
+		"ip_address>":       {"192.168.1.10"},
+		"ip_address<":       {"192.168.1.19"},
+		"_return_as_object": {"1"},
+	}, nil, &result)
+	if len(result.Result) != 10 {
+		t.Errorf("Expected 10 unused addresses in bounds, got %d", len(result.Result))
+	}
+}
+
+func TestNextAvailableNetwork(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+	server.Add("networkcontainer", map[string]interface{}{
+		"network":  "10.10.0.0/16",
+		"extattrs": map[string]interface{}{"Label": map[string]interface{}{"value": "Autonets"}},
+	})
+	server.Add("network", map[string]interface{}{"network": "10.10.0.0/24"})
+
+	var result struct {
+		Result map[string]interface{} `json:"result"`
+	}
+	doRequest(t, server, http.MethodPost, "network", url.Values{
+		"_return_fields":    {"network"},
+		"_return_as_object": {"1"},
+	}, map[string]interface{}{
+		"network": map[string]interface{}{
+			"_object_function":   "next_available_network",
+			"_result_field":      "networks",
+			"_object":            "networkcontainer",
+			"_object_parameters": map[string]interface{}{"*Label": "Autonets"},
+			"_parameters":        map[string]interface{}{"cidr": 24},
+		},
+	}, &result)
+	if result.Result["network"] != "10.10.1.0/24" {
+		t.Errorf("Expected 10.10.1.0/24 to be allocated, got %v", result.Result["network"])
+	}
+
+	containers := server.Objects("networkcontainer")
+	var networks map[string][]string
+	doRequest(t, server, http.MethodPost, containers[0]["_ref"].(string), url.Values{
+		"_function": {"next_available_network"},
+	}, map[string]interface{}{"cidr": 24, "num": 2, "exclude": []string{"10.10.2.0/24"}}, &networks)
+	if fmt.Sprint(networks["networks"]) != "[10.10.3.0/24 10.10.4.0/24]" {
+		t.Errorf("Unexpected next available networks %v", networks["networks"])
+	}
+}
+
+func TestAuthentication(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+	server.Username = "admin"
+	server.Password = "infoblox"
+
+	status := doRequest(t, server, http.MethodGet, "grid", nil, nil, nil)
+	if status != http.StatusUnauthorized {
+		t.Errorf("Expected unauthenticated request to fail, got %d", status)
+	}
+
+	request, _ := http.NewRequest(http.MethodGet, fmt.Sprintf("%s/wapi/v%s/grid", server.URL, Version), nil)
+	request.SetBasicAuth("admin", "infoblox")
+	response, err := server.Client().Do(request)
+	if err != nil {
+		t.Fatalf("Error sending request: %s", err)
+	}
+	response.Body.Close()
+	if response.StatusCode != http.StatusOK {
+		t.Fatalf("Expected authenticated request to succeed, got %d", response.StatusCode)
+	}
+	var cookie *http.Cookie
+	for _, c := range response.Cookies() {
+		if c.Name == AuthCookie {
+			cookie = c
+		}
+	}
+	if cookie == nil {
+		t.Fatalf("Expected %s cookie to be issued", AuthCookie)
+	}
+}
+
+func TestSchema(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+	server.Add("network", map[string]interface{}{"network": "10.0.0.0/24", "comment": "lab"})
+
+	var schema map[string]interface{}
+	status := doRequest(t, server, http.MethodGet, "", url.Values{"_schema": {""}}, nil, &schema)
+	if status != http.StatusOK || schema["requested_version"] != Version || len(schema["supported_versions"].([]interface{})) != len(SupportedVersions) {
+		t.Errorf("Unexpected grid schema %d %v", status, schema)
+	}
+
+	var objectSchema struct {
+		Type   string        `json:"type"`
+		Fields []SchemaField `json:"fields"`
+	}
+	doRequest(t, server, http.MethodGet, "network", url.Values{"_schema": {""}}, nil, &objectSchema)
+	var names []string
+	for _, field := range objectSchema.Fields {
+		names = append(names, field.Name)
+	}
+	if objectSchema.Type != "network" || fmt.Sprint(names) != "[comment disable network network_view]" {
+		t.Errorf("Expected basic and stored fields in network schema, got %v", names)
+	}
+
+	server.SetSchema("network", SchemaField{Name: "network", Supports: "rwus"})
+	doRequest(t, server, http.MethodGet, "network", url.Values{"_schema": {""}}, nil, &objectSchema)
+	if len(objectSchema.Fields) != 1 {
+		t.Errorf("Expected configured network schema, got %v", objectSchema.Fields)
+	}
+
+	server.Versions = []string{"2.12"}
+	if status := doRequest(t, server, http.MethodGet, "grid", nil, nil, nil); status != http.StatusBadRequest {
+		t.Errorf("Expected unsupported version to be rejected, got %d", status)
+	}
+}
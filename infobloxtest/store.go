@@ -0,0 +1,512 @@
+package infobloxtest
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// object is a stored WAPI object in its decoded JSON form
+type object map[string]interface{}
+
+func (o object) ref() string {
+	ref, _ := o["_ref"].(string)
+	return ref
+}
+
+func (o object) str(field string) string {
+	return stringValue(o[field])
+}
+
+func (o object) clone() object {
+	ret, _ := normalize(o)
+	return ret
+}
+
+// normalize round trips fields through JSON so stored values only
+// contain json.Number, string, bool, []interface{} and map values
+func normalize(fields map[string]interface{}) (object, error) {
+	ret := object{}
+	if fields == nil {
+		return ret, nil
+	}
+	raw, err := json.Marshal(fields)
+	if err != nil {
+		return nil, err
+	}
+	decoder := json.NewDecoder(strings.NewReader(string(raw)))
+	decoder.UseNumber()
+	err = decoder.Decode(&ret)
+	return ret, err
+}
+
+// refID extracts the unique identifier portion of a reference
+func refID(ref string) string {
+	_, rest, found := strings.Cut(ref, "/")
+	if !found {
+		return ""
+	}
+	id, _, _ := strings.Cut(rest, ":")
+	return id
+}
+
+// encodeID renders an opaque reference identifier
+func encodeID(objectType string, key interface{}) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(fmt.Sprintf("%s$%v", objectType, key)))
+}
+
+func (s *Server) newRef(objectType string, obj object, id string) string {
+	if id == "" {
+		s.counter++
+		id = encodeID(objectType, s.counter)
+	}
+	return fmt.Sprintf("%s/%s:%s", objectType, id, objectTypes[objectType].refName(obj))
+}
+
+func (s *Server) insert(objectType string, obj object) object {
+	obj["_ref"] = s.newRef(objectType, obj, "")
+	s.objects[objectType] = append(s.objects[objectType], obj)
+	return obj
+}
+
+func (s *Server) find(ref string) (string, int) {
+	objectType, _ := splitTarget(ref)
+	id := refID(ref)
+	for i, obj := range s.objects[objectType] {
+		if refID(obj.ref()) == id {
+			return objectType, i
+		}
+	}
+	return objectType, -1
+}
+
+func (s *Server) lookup(ref string) (object, *wapiError) {
+	objectType, i := s.find(ref)
+	if i < 0 {
+		return nil, notFoundError(ref)
+	}
+	return s.objects[objectType][i], nil
+}
+
+func (s *Server) create(objectType string, body object) (object, *wapiError) {
+	definition, ok := objectTypes[objectType]
+	if !ok || definition.list != nil {
+		return nil, protoError(http.StatusBadRequest, "Object type %s cannot be created", objectType)
+	}
+	obj := object{}
+	applyFields(obj, body)
+	if definition.prepare != nil {
+		if werr := definition.prepare(s, obj, ""); werr != nil {
+			return nil, werr
+		}
+	}
+	if werr := s.checkUnique(objectType, obj, ""); werr != nil {
+		return nil, werr
+	}
+	return s.insert(objectType, obj), nil
+}
+
+func (s *Server) update(ref string, body object) (object, *wapiError) {
+	objectType, i := s.find(ref)
+	if i < 0 {
+		return nil, notFoundError(ref)
+	}
+	definition := objectTypes[objectType]
+	current := s.objects[objectType][i]
+	obj := current.clone()
+	applyFields(obj, body)
+	if definition.prepare != nil {
+		if werr := definition.prepare(s, obj, current.ref()); werr != nil {
+			return nil, werr
+		}
+	}
+	if werr := s.checkUnique(objectType, obj, current.ref()); werr != nil {
+		return nil, werr
+	}
+	obj["_ref"] = s.newRef(objectType, obj, refID(current.ref()))
+	s.objects[objectType][i] = obj
+	return obj, nil
+}
+
+func (s *Server) delete(ref string) (object, *wapiError) {
+	objectType, i := s.find(ref)
+	if i < 0 {
+		return nil, notFoundError(ref)
+	}
+	obj := s.objects[objectType][i]
+	s.objects[objectType] = append(s.objects[objectType][:i], s.objects[objectType][i+1:]...)
+	return obj, nil
+}
+
+// applyFields copies writable fields from body handling extattrs+ and extattrs-
+func applyFields(obj object, body object) {
+	for field, value := range body {
+		switch field {
+		case "_ref":
+		case "extattrs+":
+			eas, _ := obj["extattrs"].(map[string]interface{})
+			if eas == nil {
+				eas = map[string]interface{}{}
+			}
+			if add, ok := value.(map[string]interface{}); ok {
+				for name, ea := range add {
+					eas[name] = ea
+				}
+			}
+			obj["extattrs"] = eas
+		case "extattrs-":
+			eas, _ := obj["extattrs"].(map[string]interface{})
+			if remove, ok := value.(map[string]interface{}); ok && eas != nil {
+				for name := range remove {
+					delete(eas, name)
+				}
+			}
+		default:
+			if value == nil {
+				delete(obj, field)
+			} else {
+				obj[field] = value
+			}
+		}
+	}
+}
+
+func (s *Server) checkUnique(objectType string, obj object, selfRef string) *wapiError {
+	definition := objectTypes[objectType]
+	if definition.key == nil {
+		return nil
+	}
+	key := definition.key(obj)
+	space := definition.keySpace
+	if space == "" {
+		space = objectType
+	}
+	for otherType, other := range objectTypes {
+		otherSpace := other.keySpace
+		if otherSpace == "" {
+			otherSpace = otherType
+		}
+		if otherSpace != space || other.key == nil {
+			continue
+		}
+		for _, existing := range s.objects[otherType] {
+			if selfRef != "" && refID(existing.ref()) == refID(selfRef) {
+				continue
+			}
+			if other.key(existing) == key {
+				return conflictError(objectType, key)
+			}
+		}
+	}
+	return nil
+}
+
+// search returns the objects of objectType matching the query
+func (s *Server) search(objectType string, query url.Values) (interface{}, *wapiError) {
+	definition := objectTypes[objectType]
+	candidates := s.objects[objectType]
+	if definition.list != nil {
+		var werr *wapiError
+		candidates, werr = definition.list(s, query)
+		if werr != nil {
+			return nil, werr
+		}
+	}
+	var matched []object
+	for _, obj := range candidates {
+		ok, werr := matches(obj, query)
+		if werr != nil {
+			return nil, werr
+		}
+		if ok {
+			matched = append(matched, obj)
+		}
+	}
+
+	asObject := query.Get("_return_as_object") == "1"
+	paging := query.Get("_paging") == "1"
+	maxResults := 0
+	if value := query.Get("_max_results"); value != "" {
+		parsed, err := strconv.Atoi(value)
+		if err != nil {
+			return nil, protoError(http.StatusBadRequest, "Invalid value for _max_results: %s", value)
+		}
+		maxResults = parsed
+	}
+
+	nextPageID := ""
+	if paging {
+		if !asObject {
+			return nil, protoError(http.StatusBadRequest, "_paging requires _return_as_object")
+		}
+		if maxResults <= 0 {
+			return nil, protoError(http.StatusBadRequest, "_paging requires a positive _max_results")
+		}
+		offset := 0
+		if pageID := query.Get("_page_id"); pageID != "" {
+			var werr *wapiError
+			offset, werr = decodePageID(objectType, pageID)
+			if werr != nil {
+				return nil, werr
+			}
+		}
+		if offset > len(matched) {
+			offset = len(matched)
+		}
+		end := offset + maxResults
+		if end < len(matched) {
+			nextPageID = encodePageID(objectType, end)
+		} else {
+			end = len(matched)
+		}
+		matched = matched[offset:end]
+	} else if maxResults > 0 && len(matched) > maxResults {
+		matched = matched[:maxResults]
+	} else if maxResults < 0 && len(matched) > -maxResults {
+		return nil, protoError(http.StatusBadRequest, "Result set too large (> %d)", -maxResults)
+	}
+
+	results := []interface{}{}
+	for _, obj := range matched {
+		results = append(results, s.project(objectType, obj, query))
+	}
+	if !asObject {
+		return results, nil
+	}
+	ret := map[string]interface{}{"result": results}
+	if nextPageID != "" {
+		ret["next_page_id"] = nextPageID
+	}
+	return ret, nil
+}
+
+func encodePageID(objectType string, offset int) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(fmt.Sprintf("%s:%d", objectType, offset)))
+}
+
+func decodePageID(objectType string, pageID string) (int, *wapiError) {
+	raw, err := base64.RawURLEncoding.DecodeString(pageID)
+	if err == nil {
+		prefix, offset, found := strings.Cut(string(raw), ":")
+		if found && prefix == objectType {
+			if value, err := strconv.Atoi(offset); err == nil && value >= 0 {
+				return value, nil
+			}
+		}
+	}
+	return 0, protoError(http.StatusBadRequest, "Invalid page id %s", pageID)
+}
+
+// project returns the fields of obj selected by _return_fields or _return_fields+
+func (s *Server) project(objectType string, obj object, query url.Values) object {
+	if expand := objectTypes[objectType].expand; expand != nil {
+		obj = obj.clone()
+		expand(s, obj)
+	}
+	fields := objectTypes[objectType].basicFields
+	if returnFields, ok := query["_return_fields"]; ok {
+		fields = splitFields(returnFields)
+	}
+	if extra, ok := query["_return_fields+"]; ok {
+		fields = append(append([]string(nil), fields...), splitFields(extra)...)
+	}
+	ret := object{"_ref": obj.ref()}
+	for _, field := range fields {
+		value, ok := obj[field]
+		if !ok {
+			if field == "extattrs" {
+				ret[field] = map[string]interface{}{}
+			}
+			continue
+		}
+		ret[field] = value
+	}
+	return ret.clone()
+}
+
+func splitFields(values []string) []string {
+	var ret []string
+	for _, value := range values {
+		for _, field := range strings.Split(value, ",") {
+			field = strings.TrimSpace(field)
+			if field == "" {
+				continue
+			}
+			// Sub-field selections such as ipv4addrs.host return the
+			// whole top level field
+			field, _, _ = strings.Cut(field, ".")
+			ret = append(ret, field)
+		}
+	}
+	return ret
+}
+
+// searchKey is a query argument split into its field and search modifiers
+type searchKey struct {
+	field           string
+	extensible      bool
+	regex           bool
+	caseInsensitive bool
+	negate          bool
+	lessOrEqual     bool
+	greaterOrEqual  bool
+}
+
+func parseSearchKey(key string) searchKey {
+	ret := searchKey{}
+	for len(key) > 0 {
+		last := key[len(key)-1]
+		switch last {
+		case '~':
+			ret.regex = true
+		case ':':
+			ret.caseInsensitive = true
+		case '!':
+			ret.negate = true
+		case '<':
+			ret.lessOrEqual = true
+		case '>':
+			ret.greaterOrEqual = true
+		default:
+			ret.field = key
+			if strings.HasPrefix(key, "*") {
+				ret.extensible = true
+				ret.field = strings.TrimPrefix(key, "*")
+			}
+			return ret
+		}
+		key = key[:len(key)-1]
+	}
+	return ret
+}
+
+// matches reports whether obj satisfies every search argument in query
+func matches(obj object, query url.Values) (bool, *wapiError) {
+	for key, values := range query {
+		if strings.HasPrefix(key, "_") || key == "" {
+			continue
+		}
+		search := parseSearchKey(key)
+		for _, value := range values {
+			ok, werr := matchValue(obj, search, value)
+			if werr != nil {
+				return false, werr
+			}
+			if !ok {
+				return false, nil
+			}
+		}
+	}
+	return true, nil
+}
+
+func matchValue(obj object, search searchKey, value string) (bool, *wapiError) {
+	candidates := fieldValues(obj, search)
+	var pattern *regexp.Regexp
+	if search.regex {
+		expression := value
+		if search.caseInsensitive {
+			expression = "(?i)" + expression
+		}
+		var err error
+		pattern, err = regexp.Compile(expression)
+		if err != nil {
+			return false, protoError(http.StatusBadRequest, "Invalid regular expression %s", value)
+		}
+	}
+	matched := false
+	for _, candidate := range candidates {
+		switch {
+		case pattern != nil:
+			matched = pattern.MatchString(candidate)
+		case search.lessOrEqual:
+			matched = compareValues(candidate, value) <= 0
+		case search.greaterOrEqual:
+			matched = compareValues(candidate, value) >= 0
+		case search.caseInsensitive:
+			matched = strings.EqualFold(candidate, value)
+		default:
+			matched = candidate == value
+		}
+		if matched {
+			break
+		}
+	}
+	if search.negate {
+		return !matched, nil
+	}
+	return matched, nil
+}
+
+// fieldValues returns the string values of a searched field, flattening lists
+func fieldValues(obj object, search searchKey) []string {
+	var raw interface{}
+	if search.extensible {
+		eas, _ := obj["extattrs"].(map[string]interface{})
+		ea, _ := eas[search.field].(map[string]interface{})
+		raw = ea["value"]
+	} else {
+		raw = obj[search.field]
+	}
+	if raw == nil {
+		return nil
+	}
+	if list, ok := raw.([]interface{}); ok {
+		var ret []string
+		for _, item := range list {
+			ret = append(ret, stringValue(item))
+		}
+		return ret
+	}
+	return []string{stringValue(raw)}
+}
+
+func stringValue(value interface{}) string {
+	switch v := value.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case json.Number:
+		return v.String()
+	case bool:
+		return strconv.FormatBool(v)
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+// compareValues compares as IP addresses, then numbers, then strings
+func compareValues(a string, b string) int {
+	if ipA, ipB := parseIP(a), parseIP(b); ipA != nil && ipB != nil {
+		return ipA.Cmp(ipB)
+	}
+	if numA, errA := strconv.ParseFloat(a, 64); errA == nil {
+		if numB, errB := strconv.ParseFloat(b, 64); errB == nil {
+			switch {
+			case numA < numB:
+				return -1
+			case numA > numB:
+				return 1
+			}
+			return 0
+		}
+	}
+	return strings.Compare(a, b)
+}
+
+// sortedKeys returns the keys of m in order
+func sortedKeys(m map[string]interface{}) []string {
+	var ret []string
+	for key := range m {
+		ret = append(ret, key)
+	}
+	sort.Strings(ret)
+	return ret
+}
@@ -1,6 +1,7 @@
 package infoblox
 
 import (
+	"context"
 	"fmt"
 	"net"
 	"net/http"
@@ -10,154 +11,96 @@ import (
 
 const (
 	ipv4AddressBasePath = "ipv4address"
+	sequentialPageSize  = 100
 )
 
 // GetSequentialAddressRange retrieves count number of sequential IPs from supplied network
 func (c *Client) GetSequentialAddressRange(query AddressQuery) (*[]IPv4Address, error) {
+	return c.GetSequentialAddressRangeWithContext(context.Background(), query)
+}
+
+// GetSequentialAddressRangeWithContext retrieves count number of sequential IPs from supplied network using the supplied context.
+// Unused ipv4address objects are paged rather than calling next_available_ip, which has no start
+// address and would need every free address already examined in its exclude list
+func (c *Client) GetSequentialAddressRangeWithContext(ctx context.Context, query AddressQuery) (*[]IPv4Address, error) {
 	var addresses []IPv4Address
-	var ret AddressQueryResult
-	var prevPage []IPv4Address
-	startIndex := -1
-	var endIndex int
-	matchFlag := false
-	rangeMatchFlag := false
 
-	query.fillDefaults()
-	queryParams := map[string]string{
-		"network":           query.CIDR,
-		"network_view":      query.NetworkView,
-		"status":            "UNUSED",
-		"_return_as_object": "1",
-		"_paging":           "1",
-		"_max_results":      "100",
-		"_return_fields":    "ip_address,network,network_view,status",
+	query.fillDefaults(c.config.NetworkView)
+	if query.Count <= 0 {
+		return &addresses, fmt.Errorf("%w: count must be positive, got %d", ErrValidation, query.Count)
 	}
-	if query.StartAddress != "" {
-		queryParams["ip_address>"] = query.StartAddress
+	networks, err := c.GetNetworkByQueryWithContext(ctx, map[string]string{
+		"network":      query.CIDR,
+		"network_view": query.NetworkView,
+	})
+	if err != nil {
+		return &addresses, err
 	}
-	if query.EndAddress != "" {
-		queryParams["ip_address<"] = query.EndAddress
+	if len(networks) == 0 {
+		return &addresses, fmt.Errorf("%w: network %s in network view %s", ErrNotFound, query.CIDR, query.NetworkView)
 	}
-	queryParamString := c.BuildQuery(queryParams)
-
-	request, err := c.CreateJSONRequest(http.MethodGet, fmt.Sprintf("%s?%s", ipv4AddressBasePath, queryParamString), nil)
+	ranges, err := c.ListRangesWithContext(ctx, map[string]string{
+		"network":      query.CIDR,
+		"network_view": query.NetworkView,
+	})
 	if err != nil {
 		return &addresses, err
 	}
 
-	response := c.Call(request, &ret)
-	if response != nil {
-		return &addresses, fmt.Errorf(response.ErrorMessage)
+	// Unused addresses are paged in ascending order from the start address
+	// so each request only carries the position reached by the previous page
+	params := map[string]string{
+		"network":        query.CIDR,
+		"network_view":   query.NetworkView,
+		"status":         "UNUSED",
+		"_return_fields": "ip_address,network,network_view,status",
 	}
-
-	_, network, _ := net.ParseCIDR(query.CIDR)
-	rangePage, err := c.GetPaginatedCidrRanges(query.CIDR, "")
-	if err != nil {
-		return &addresses, err
+	if query.StartAddress != "" {
+		params["ip_address>"] = query.StartAddress
 	}
-	for !matchFlag {
-		resultsCount := len(ret.Results)
-		if ret.NextPageID == "" && ((len(prevPage) == 0 && resultsCount < query.Count) || (len(prevPage) > 0 && ((len(prevPage)-startIndex)+resultsCount) < query.Count)) {
-			return &addresses, fmt.Errorf("no sequential block found for supplied count")
-		}
-		if startIndex == -1 {
-			startIndex = 0
-			endIndex = query.Count - 1
-		} else {
-			endIndex = 0
+	if query.EndAddress != "" {
+		params["ip_address<"] = query.EndAddress
+	}
+	var block []IPv4Address
+	it := NewIterator[IPv4Address](ctx, c, ipv4AddressBasePath, params, sequentialPageSize)
+	for it.Next() {
+		address := it.Value()
+		inRange := false
+		for _, addressRange := range ranges {
+			inRange = inRange || ipWithinRange(addressRange.StartAddress, addressRange.EndAddress, address.IPAddress)
 		}
-		for endIndex <= resultsCount && !matchFlag {
-			var currentMatch ipmath.IP
-			var lastMatch ipmath.IP
-
-			if startIndex > endIndex {
-				currentMatch = ipmath.IP{
-					Address: net.ParseIP(prevPage[startIndex].IPAddress),
-					Network: network,
-				}
-			} else {
-				currentMatch = ipmath.IP{
-					Address: net.ParseIP(ret.Results[startIndex].IPAddress),
-					Network: network,
-				}
-			}
-			lastMatch = ipmath.IP{
-				Address: net.ParseIP(ret.Results[endIndex].IPAddress),
-				Network: network,
-			}
-
-			if currentMatch.Difference(lastMatch.Address) == (query.Count - 1) {
-				if len(rangePage.Results) > 0 {
-					for !rangeMatchFlag {
-						for _, addressRange := range rangePage.Results {
-							if ipWithinRange(addressRange.StartAddress, addressRange.EndAddress, currentMatch.Address.String()) || ipWithinRange(addressRange.StartAddress, addressRange.EndAddress, lastMatch.Address.String()) {
-								rangeMatchFlag = true
-								break
-							}
-						}
-						if !rangeMatchFlag && rangePage.NextPageID != "" {
-							rangePage, err = c.GetPaginatedCidrRanges(query.CIDR, rangePage.NextPageID)
-							if err != nil {
-								return &addresses, err
-							}
-						} else if !rangeMatchFlag && rangePage.NextPageID == "" {
-							matchFlag = true
-							break
-						}
-					}
-				} else {
-					matchFlag = true
-				}
-				if matchFlag {
-					for i := 0; i <= query.Count-1; i++ {
-						if startIndex > endIndex {
-							addresses = append(addresses, prevPage[startIndex])
-						} else {
-							addresses = append(addresses, ret.Results[startIndex])
-						}
-						if len(prevPage) > 0 && startIndex == len(prevPage)-1 {
-							startIndex = 0
-						} else {
-							startIndex++
-						}
-					}
-					break
-				}
-			}
-			if len(prevPage) > 0 && startIndex == len(prevPage)-1 {
-				startIndex = 0
-			} else {
-				startIndex++
+		switch {
+		case inRange:
+			block = nil
+			continue
+		case len(block) > 0:
+			previous := ipmath.IP{Address: net.ParseIP(block[len(block)-1].IPAddress)}
+			if previous.Difference(net.ParseIP(address.IPAddress)) != 1 {
+				block = nil
 			}
-			endIndex++
 		}
-		if !matchFlag && ret.NextPageID != "" {
-			prevPage = ret.Results
-			queryParams["_page_id"] = ret.NextPageID
-			queryParamString := c.BuildQuery(queryParams)
-
-			request, err := c.CreateJSONRequest(http.MethodGet, fmt.Sprintf("ipv4address%s", queryParamString), nil)
-			if err != nil {
-				return &addresses, err
-			}
-
-			response := c.Call(request, &ret)
-			if response != nil {
-				return &addresses, fmt.Errorf(response.ErrorMessage)
-			}
-		} else if !matchFlag && ret.NextPageID == "" {
-			return &addresses, fmt.Errorf("no sequential block found for supplied count")
+		block = append(block, address)
+		if len(block) == query.Count {
+			return &block, nil
 		}
 	}
-	return &addresses, nil
+	if err := it.Err(); err != nil {
+		return &addresses, err
+	}
+	return &addresses, fmt.Errorf("no sequential block found for supplied count")
 }
 
 // GetUsedAddressesWithinRange gets used addresses within selected network range
 func (c *Client) GetUsedAddressesWithinRange(query AddressQuery) (*[]IPv4Address, error) {
+	return c.GetUsedAddressesWithinRangeWithContext(context.Background(), query)
+}
+
+// GetUsedAddressesWithinRangeWithContext gets used addresses within selected network range using the supplied context
+func (c *Client) GetUsedAddressesWithinRangeWithContext(ctx context.Context, query AddressQuery) (*[]IPv4Address, error) {
 	var addresses []IPv4Address
 	var ret AddressQueryResult
 
-	query.fillDefaults()
+	query.fillDefaults(c.config.NetworkView)
 	queryParams := map[string]string{
 		"network":           query.CIDR,
 		"network_view":      query.NetworkView,
@@ -167,14 +110,14 @@ func (c *Client) GetUsedAddressesWithinRange(query AddressQuery) (*[]IPv4Address
 		"_return_fields":    "ip_address,network,network_view,status,names,objects",
 	}
 	queryParamString := c.BuildQuery(queryParams)
-	request, err := c.CreateJSONRequest(http.MethodGet, fmt.Sprintf("%s?%s", ipv4AddressBasePath, queryParamString), nil)
+	request, err := c.CreateJSONRequestWithContext(ctx, http.MethodGet, fmt.Sprintf("%s?%s", ipv4AddressBasePath, queryParamString), nil)
 	if err != nil {
 		return &addresses, err
 	}
 
 	response := c.Call(request, &ret)
 	if response != nil {
-		return &addresses, fmt.Errorf(response.ErrorMessage)
+		return &addresses, response
 	}
 	var filteredResults []IPv4Address
 	if *query.FilterEmptyHostnames {
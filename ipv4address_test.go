@@ -4,19 +4,11 @@
 package infoblox
 
 import (
-	"os"
 	"testing"
 )
 
 var (
-	ipv4AddressConfig = Config{
-		Host:                   os.Getenv("INFOBLOX_HOST"),
-		Port:                   os.Getenv("INFOBLOX_PORT"),
-		Username:               os.Getenv("INFOBLOX_USERNAME"),
-		Password:               os.Getenv("INFOBLOX_PASSWORD"),
-		Version:                os.Getenv("INFOBLOX_VERSION"),
-		DisableTLSVerification: true,
-	}
+	ipv4AddressConfig          = testConfig()
 	ipv4AddressClient          = New(ipv4AddressConfig)
 	ipv4AddressSequentialQuery = AddressQuery{
 		CIDR:  "172.16.106.0/24",
@@ -0,0 +1,127 @@
+package infoblox
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+const (
+	ipv6ContainerBasePath     = "ipv6networkcontainer"
+	ipv6ContainerReturnFields = "comment,network,network_view,extattrs,options,use_options,preferred_lifetime,use_preferred_lifetime,valid_lifetime,use_valid_lifetime"
+)
+
+// GetIPv6ContainerByRef gets IPv6 network container by reference
+func (c *Client) GetIPv6ContainerByRef(ref string, queryParams map[string]string) (IPv6NetworkContainer, error) {
+	return c.GetIPv6ContainerByRefWithContext(context.Background(), ref, queryParams)
+}
+
+// GetIPv6ContainerByRefWithContext gets IPv6 network container by reference using the supplied context
+func (c *Client) GetIPv6ContainerByRefWithContext(ctx context.Context, ref string, queryParams map[string]string) (IPv6NetworkContainer, error) {
+	var ret IPv6NetworkContainer
+	queryParams = returnFields(queryParams, ipv6ContainerReturnFields)
+
+	queryParamString := c.BuildQuery(queryParams)
+
+	request, err := c.CreateJSONRequestWithContext(ctx, http.MethodGet, fmt.Sprintf("%s?%s", ref, queryParamString), nil)
+	if err != nil {
+		return ret, err
+	}
+
+	response := c.Call(request, &ret)
+	if response != nil {
+		return ret, response
+	}
+
+	return ret, nil
+}
+
+// GetIPv6ContainerByQuery gets IPv6 network containers by query parameters
+func (c *Client) GetIPv6ContainerByQuery(queryParams map[string]string) ([]IPv6NetworkContainer, error) {
+	return c.GetIPv6ContainerByQueryWithContext(context.Background(), queryParams)
+}
+
+// GetIPv6ContainerByQueryWithContext gets IPv6 network containers by query parameters using the supplied context
+func (c *Client) GetIPv6ContainerByQueryWithContext(ctx context.Context, queryParams map[string]string) ([]IPv6NetworkContainer, error) {
+	return c.ListIPv6ContainersWithContext(ctx, queryParams)
+}
+
+// ListIPv6Containers lists all IPv6 network containers matching query parameters following every result page
+func (c *Client) ListIPv6Containers(queryParams map[string]string) ([]IPv6NetworkContainer, error) {
+	return c.ListIPv6ContainersWithContext(context.Background(), queryParams)
+}
+
+// ListIPv6ContainersWithContext lists all IPv6 network containers matching query parameters following every result page using the supplied context
+func (c *Client) ListIPv6ContainersWithContext(ctx context.Context, queryParams map[string]string) ([]IPv6NetworkContainer, error) {
+	return listWithReturnFields[IPv6NetworkContainer](ctx, c, ipv6ContainerBasePath, ipv6ContainerReturnFields, queryParams)
+}
+
+// CreateIPv6Container creates IPv6 network container
+func (c *Client) CreateIPv6Container(container *IPv6NetworkContainer) error {
+	return c.CreateIPv6ContainerWithContext(context.Background(), container)
+}
+
+// CreateIPv6ContainerWithContext creates IPv6 network container using the supplied context
+func (c *Client) CreateIPv6ContainerWithContext(ctx context.Context, container *IPv6NetworkContainer) error {
+	container.NetworkView = c.networkView(container.NetworkView)
+	queryParams := map[string]string{
+		"_return_fields": ipv6ContainerReturnFields,
+	}
+	queryParamString := c.BuildQuery(queryParams)
+	request, err := c.CreateJSONRequestWithContext(ctx, http.MethodPost, fmt.Sprintf("%s?%s", ipv6ContainerBasePath, queryParamString), container)
+	if err != nil {
+		return err
+	}
+
+	response := c.Call(request, &container)
+	if response != nil {
+		return response
+	}
+	return nil
+}
+
+// UpdateIPv6Container updates IPv6 network container
+func (c *Client) UpdateIPv6Container(ref string, container IPv6NetworkContainer) (IPv6NetworkContainer, error) {
+	return c.UpdateIPv6ContainerWithContext(context.Background(), ref, container)
+}
+
+// UpdateIPv6ContainerWithContext updates IPv6 network container using the supplied context
+func (c *Client) UpdateIPv6ContainerWithContext(ctx context.Context, ref string, container IPv6NetworkContainer) (IPv6NetworkContainer, error) {
+	var ret IPv6NetworkContainer
+	queryParams := map[string]string{
+		"_return_fields": ipv6ContainerReturnFields,
+	}
+	queryParamString := c.BuildQuery(queryParams)
+	request, err := c.CreateJSONRequestWithContext(ctx, http.MethodPut, fmt.Sprintf("%s?%s", ref, queryParamString), container)
+	if err != nil {
+		return ret, err
+	}
+
+	response := c.Call(request, &ret)
+	if response != nil {
+		return ret, response
+	}
+	return ret, nil
+}
+
+// DeleteIPv6Container deletes IPv6 network container
+func (c *Client) DeleteIPv6Container(ref string) error {
+	return c.DeleteIPv6ContainerWithContext(context.Background(), ref)
+}
+
+// DeleteIPv6ContainerWithContext deletes IPv6 network container using the supplied context
+func (c *Client) DeleteIPv6ContainerWithContext(ctx context.Context, ref string) error {
+	request, err := c.CreateJSONRequestWithContext(ctx, http.MethodDelete, ref, nil)
+	if err != nil {
+		return err
+	}
+
+	response := c.Call(request, nil)
+	if response != nil {
+		if IsNotFound(response) {
+			return nil
+		}
+		return response
+	}
+	return nil
+}
@@ -0,0 +1,127 @@
+package infoblox
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+const (
+	ipv6FixedAddressBasePath     = "ipv6fixedaddress"
+	ipv6FixedAddressReturnFields = "extattrs,ipv6addr,duid,network_view,disable,comment,name,address_type,ipv6prefix,ipv6prefix_bits,network"
+)
+
+// GetIPv6FixedAddressByRef gets IPv6 fixed address by reference
+func (c *Client) GetIPv6FixedAddressByRef(ref string, queryParams map[string]string) (IPv6FixedAddress, error) {
+	return c.GetIPv6FixedAddressByRefWithContext(context.Background(), ref, queryParams)
+}
+
+// GetIPv6FixedAddressByRefWithContext gets IPv6 fixed address by reference using the supplied context
+func (c *Client) GetIPv6FixedAddressByRefWithContext(ctx context.Context, ref string, queryParams map[string]string) (IPv6FixedAddress, error) {
+	var ret IPv6FixedAddress
+
+	queryParams = returnFields(queryParams, ipv6FixedAddressReturnFields)
+
+	queryParamString := c.BuildQuery(queryParams)
+	request, err := c.CreateJSONRequestWithContext(ctx, http.MethodGet, fmt.Sprintf("%s?%s", ref, queryParamString), nil)
+	if err != nil {
+		return ret, err
+	}
+
+	response := c.Call(request, &ret)
+	if response != nil {
+		return ret, response
+	}
+
+	return ret, nil
+}
+
+// GetIPv6FixedAddressByQuery gets IPv6 fixed address by query parameters
+func (c *Client) GetIPv6FixedAddressByQuery(queryParams map[string]string) ([]IPv6FixedAddress, error) {
+	return c.GetIPv6FixedAddressByQueryWithContext(context.Background(), queryParams)
+}
+
+// GetIPv6FixedAddressByQueryWithContext gets IPv6 fixed address by query parameters using the supplied context
+func (c *Client) GetIPv6FixedAddressByQueryWithContext(ctx context.Context, queryParams map[string]string) ([]IPv6FixedAddress, error) {
+	return c.ListIPv6FixedAddressesWithContext(ctx, queryParams)
+}
+
+// ListIPv6FixedAddresses lists all IPv6 fixed addresses matching query parameters following every result page
+func (c *Client) ListIPv6FixedAddresses(queryParams map[string]string) ([]IPv6FixedAddress, error) {
+	return c.ListIPv6FixedAddressesWithContext(context.Background(), queryParams)
+}
+
+// ListIPv6FixedAddressesWithContext lists all IPv6 fixed addresses matching query parameters following every result page using the supplied context
+func (c *Client) ListIPv6FixedAddressesWithContext(ctx context.Context, queryParams map[string]string) ([]IPv6FixedAddress, error) {
+	return listWithReturnFields[IPv6FixedAddress](ctx, c, ipv6FixedAddressBasePath, ipv6FixedAddressReturnFields, queryParams)
+}
+
+// CreateIPv6FixedAddress creates IPv6 fixed address
+func (c *Client) CreateIPv6FixedAddress(fixedAddress *IPv6FixedAddress) error {
+	return c.CreateIPv6FixedAddressWithContext(context.Background(), fixedAddress)
+}
+
+// CreateIPv6FixedAddressWithContext creates IPv6 fixed address using the supplied context
+func (c *Client) CreateIPv6FixedAddressWithContext(ctx context.Context, fixedAddress *IPv6FixedAddress) error {
+	fixedAddress.NetworkView = c.networkView(fixedAddress.NetworkView)
+	queryParams := map[string]string{
+		"_return_fields": ipv6FixedAddressReturnFields,
+	}
+	queryParamString := c.BuildQuery(queryParams)
+	request, err := c.CreateJSONRequestWithContext(ctx, http.MethodPost, fmt.Sprintf("%s?%s", ipv6FixedAddressBasePath, queryParamString), fixedAddress)
+	if err != nil {
+		return err
+	}
+
+	response := c.Call(request, &fixedAddress)
+	if response != nil {
+		return response
+	}
+	return nil
+}
+
+// UpdateIPv6FixedAddress updates IPv6 fixed address
+func (c *Client) UpdateIPv6FixedAddress(ref string, fixedAddress IPv6FixedAddress) (IPv6FixedAddress, error) {
+	return c.UpdateIPv6FixedAddressWithContext(context.Background(), ref, fixedAddress)
+}
+
+// UpdateIPv6FixedAddressWithContext updates IPv6 fixed address using the supplied context
+func (c *Client) UpdateIPv6FixedAddressWithContext(ctx context.Context, ref string, fixedAddress IPv6FixedAddress) (IPv6FixedAddress, error) {
+	var ret IPv6FixedAddress
+	queryParams := map[string]string{
+		"_return_fields": ipv6FixedAddressReturnFields,
+	}
+	queryParamString := c.BuildQuery(queryParams)
+	request, err := c.CreateJSONRequestWithContext(ctx, http.MethodPut, fmt.Sprintf("%s?%s", ref, queryParamString), fixedAddress)
+	if err != nil {
+		return ret, err
+	}
+
+	response := c.Call(request, &ret)
+	if response != nil {
+		return ret, response
+	}
+	return ret, nil
+}
+
+// DeleteIPv6FixedAddress deletes IPv6 fixed address
+func (c *Client) DeleteIPv6FixedAddress(ref string) error {
+	return c.DeleteIPv6FixedAddressWithContext(context.Background(), ref)
+}
+
+// DeleteIPv6FixedAddressWithContext deletes IPv6 fixed address using the supplied context
+func (c *Client) DeleteIPv6FixedAddressWithContext(ctx context.Context, ref string) error {
+	request, err := c.CreateJSONRequestWithContext(ctx, http.MethodDelete, fmt.Sprintf("%s", ref), nil)
+	if err != nil {
+		return err
+	}
+
+	response := c.Call(request, nil)
+	if response != nil {
+		if IsNotFound(response) {
+			return nil
+		}
+		return response
+	}
+	return nil
+}
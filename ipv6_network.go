@@ -0,0 +1,159 @@
+package infoblox
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+const (
+	ipv6NetworkBasePath     = "ipv6network"
+	ipv6NetworkReturnFields = "network,network_view,comment,disable,extattrs,members,options,use_options,domain_name,use_domain_name,domain_name_servers,use_domain_name_servers,preferred_lifetime,use_preferred_lifetime,valid_lifetime,use_valid_lifetime"
+)
+
+// GetIPv6NetworkByRef gets IPv6 network by reference
+func (c *Client) GetIPv6NetworkByRef(ref string, queryParams map[string]string) (IPv6Network, error) {
+	return c.GetIPv6NetworkByRefWithContext(context.Background(), ref, queryParams)
+}
+
+// GetIPv6NetworkByRefWithContext gets IPv6 network by reference using the supplied context
+func (c *Client) GetIPv6NetworkByRefWithContext(ctx context.Context, ref string, queryParams map[string]string) (IPv6Network, error) {
+	var ret IPv6Network
+	queryParams = returnFields(queryParams, ipv6NetworkReturnFields)
+
+	queryParamString := c.BuildQuery(queryParams)
+
+	request, err := c.CreateJSONRequestWithContext(ctx, http.MethodGet, fmt.Sprintf("%s?%s", ref, queryParamString), nil)
+	if err != nil {
+		return ret, err
+	}
+
+	response := c.Call(request, &ret)
+	if response != nil {
+		return ret, response
+	}
+
+	return ret, nil
+}
+
+// GetIPv6NetworkByQuery gets IPv6 network by query parameters
+func (c *Client) GetIPv6NetworkByQuery(queryParams map[string]string) ([]IPv6Network, error) {
+	return c.GetIPv6NetworkByQueryWithContext(context.Background(), queryParams)
+}
+
+// GetIPv6NetworkByQueryWithContext gets IPv6 network by query parameters using the supplied context
+func (c *Client) GetIPv6NetworkByQueryWithContext(ctx context.Context, queryParams map[string]string) ([]IPv6Network, error) {
+	return c.ListIPv6NetworksWithContext(ctx, queryParams)
+}
+
+// ListIPv6Networks lists all IPv6 networks matching query parameters following every result page
+func (c *Client) ListIPv6Networks(queryParams map[string]string) ([]IPv6Network, error) {
+	return c.ListIPv6NetworksWithContext(context.Background(), queryParams)
+}
+
+// ListIPv6NetworksWithContext lists all IPv6 networks matching query parameters following every result page using the supplied context
+func (c *Client) ListIPv6NetworksWithContext(ctx context.Context, queryParams map[string]string) ([]IPv6Network, error) {
+	return listWithReturnFields[IPv6Network](ctx, c, ipv6NetworkBasePath, ipv6NetworkReturnFields, queryParams)
+}
+
+// CreateIPv6Network creates IPv6 network
+func (c *Client) CreateIPv6Network(network *IPv6Network) error {
+	return c.CreateIPv6NetworkWithContext(context.Background(), network)
+}
+
+// CreateIPv6NetworkWithContext creates IPv6 network using the supplied context
+func (c *Client) CreateIPv6NetworkWithContext(ctx context.Context, network *IPv6Network) error {
+	network.NetworkView = c.networkView(network.NetworkView)
+	queryParams := map[string]string{
+		"_return_fields": ipv6NetworkReturnFields,
+	}
+	queryParamString := c.BuildQuery(queryParams)
+	request, err := c.CreateJSONRequestWithContext(ctx, http.MethodPost, fmt.Sprintf("%s?%s", ipv6NetworkBasePath, queryParamString), network)
+	if err != nil {
+		return err
+	}
+
+	response := c.Call(request, &network)
+	if response != nil {
+		return response
+	}
+	return nil
+}
+
+// CreateIPv6NetworkFromContainer creates IPv6 network
+func (c *Client) CreateIPv6NetworkFromContainer(container *IPv6NetworkFromContainer) (IPv6Network, error) {
+	return c.CreateIPv6NetworkFromContainerWithContext(context.Background(), container)
+}
+
+// CreateIPv6NetworkFromContainerWithContext creates IPv6 network using the supplied context
+func (c *Client) CreateIPv6NetworkFromContainerWithContext(ctx context.Context, container *IPv6NetworkFromContainer) (IPv6Network, error) {
+	container.NetworkView = c.networkView(container.NetworkView)
+	var ret IPv6Network
+	queryParams := map[string]string{
+		"_return_fields":    ipv6NetworkReturnFields,
+		"_return_as_object": "1",
+	}
+	queryParamString := c.BuildQuery(queryParams)
+	request, err := c.CreateJSONRequestWithContext(ctx, http.MethodPost, fmt.Sprintf("%s?%s", ipv6NetworkBasePath, queryParamString), container)
+	if err != nil {
+		return ret, err
+	}
+
+	var result NetworkFromContainerResult
+	response := c.Call(request, &result)
+	if response != nil {
+		return ret, response
+	}
+	ret, err = c.GetIPv6NetworkByRefWithContext(ctx, result.Result.Ref, nil)
+	if err != nil {
+		return ret, err
+	}
+
+	return ret, nil
+}
+
+// UpdateIPv6Network updates IPv6 network
+func (c *Client) UpdateIPv6Network(ref string, network IPv6Network) (IPv6Network, error) {
+	return c.UpdateIPv6NetworkWithContext(context.Background(), ref, network)
+}
+
+// UpdateIPv6NetworkWithContext updates IPv6 network using the supplied context
+func (c *Client) UpdateIPv6NetworkWithContext(ctx context.Context, ref string, network IPv6Network) (IPv6Network, error) {
+	var ret IPv6Network
+	queryParams := map[string]string{
+		"_return_fields": ipv6NetworkReturnFields,
+	}
+	queryParamString := c.BuildQuery(queryParams)
+	request, err := c.CreateJSONRequestWithContext(ctx, http.MethodPut, fmt.Sprintf("%s?%s", ref, queryParamString), network)
+	if err != nil {
+		return ret, err
+	}
+
+	response := c.Call(request, &ret)
+	if response != nil {
+		return ret, response
+	}
+	return ret, nil
+}
+
+// DeleteIPv6Network deletes IPv6 network
+func (c *Client) DeleteIPv6Network(ref string) error {
+	return c.DeleteIPv6NetworkWithContext(context.Background(), ref)
+}
+
+// DeleteIPv6NetworkWithContext deletes IPv6 network using the supplied context
+func (c *Client) DeleteIPv6NetworkWithContext(ctx context.Context, ref string) error {
+	request, err := c.CreateJSONRequestWithContext(ctx, http.MethodDelete, ref, nil)
+	if err != nil {
+		return err
+	}
+
+	response := c.Call(request, nil)
+	if response != nil {
+		if IsNotFound(response) {
+			return nil
+		}
+		return response
+	}
+	return nil
+}
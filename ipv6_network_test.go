@@ -0,0 +1,167 @@
+//go:build all || unittests
+// +build all unittests
+
+package infoblox
+
+import (
+	"net"
+	"testing"
+)
+
+var (
+	ipv6NetworkConfig = testConfig()
+	ipv6NetworkClient = New(ipv6NetworkConfig)
+	testIPv6Container = IPv6NetworkContainer{
+		CIDR:        "fd00:19::/48",
+		NetworkView: "default",
+		Comment:     "IPv6 Container Testing",
+		ExtensibleAttributes: newExtensibleAttribute(ExtensibleAttribute{
+			"Label": ExtensibleAttributeValue{
+				Value: "Autonets6",
+			},
+		}),
+	}
+	testIPv6Network = IPv6Network{
+		CIDR:        "fd00:19:0:10::/64",
+		NetworkView: "default",
+		Comment:     "IPv6 Static Testing",
+		ExtensibleAttributes: newExtensibleAttribute(ExtensibleAttribute{
+			"Owner": ExtensibleAttributeValue{
+				Value: "testUser",
+			},
+		}),
+	}
+	testIPv6NetworkFromContainerByEa = IPv6NetworkFromContainer{
+		Network: NetworkContainerFunction{
+			Function:    "next_available_network",
+			ResultField: "networks",
+			Object:      "ipv6networkcontainer",
+			ObjectParameters: map[string]string{
+				"*Label": "Autonets6",
+			},
+			Parameters: map[string]int{
+				"cidr": 64,
+			},
+		},
+		NetworkView: "default",
+		Comment:     "Test Auto IPv6 Network By EA",
+	}
+)
+
+var testIPv6NetworkFromContainerResolved IPv6Network
+
+func TestCreateIPv6Container(t *testing.T) {
+	err := ipv6NetworkClient.CreateIPv6Container(&testIPv6Container)
+	if err != nil {
+		t.Errorf("Error creating IPv6 container: %s", err)
+	}
+}
+
+func TestUpdateIPv6Container(t *testing.T) {
+	updates := IPv6NetworkContainer{
+		Comment: "IPv6 Container Testing Updated",
+	}
+	container, err := ipv6NetworkClient.UpdateIPv6Container(testIPv6Container.Ref, updates)
+	if err != nil {
+		t.Errorf("Error updating IPv6 container: %s", err)
+	}
+	if container.Comment != updates.Comment {
+		t.Errorf("Error updating IPv6 container. Comment does not match expected value")
+	}
+}
+
+func TestCreateIPv6Network(t *testing.T) {
+	err := ipv6NetworkClient.CreateIPv6Network(&testIPv6Network)
+	if err != nil {
+		t.Errorf("Error creating IPv6 network: %s", err)
+	}
+}
+
+func TestGetIPv6Network(t *testing.T) {
+	network, err := ipv6NetworkClient.GetIPv6NetworkByRef(testIPv6Network.Ref, nil)
+	if err != nil {
+		t.Errorf("Error retrieving IPv6 network: %s", err)
+	}
+	if network.CIDR != testIPv6Network.CIDR {
+		t.Errorf("Expected IPv6 network %s, got %s", testIPv6Network.CIDR, network.CIDR)
+	}
+	networks, err := ipv6NetworkClient.GetIPv6NetworkByQuery(map[string]string{"network": testIPv6Network.CIDR})
+	if err != nil {
+		t.Errorf("Error querying IPv6 networks: %s", err)
+	}
+	if len(networks) != 1 {
+		t.Errorf("Expected 1 IPv6 network, got %d", len(networks))
+	}
+}
+
+func TestUpdateIPv6Network(t *testing.T) {
+	lifetime := 3600
+	updates := IPv6Network{
+		Comment:              "IPv6 testing updated",
+		PreferredLifetime:    &lifetime,
+		UsePreferredLifetime: newBool(true),
+		ExtensibleAttributesAdd: newExtensibleAttribute(ExtensibleAttribute{
+			"Location": ExtensibleAttributeValue{
+				Value: "austin",
+			},
+		}),
+	}
+	network, err := ipv6NetworkClient.UpdateIPv6Network(testIPv6Network.Ref, updates)
+	if err != nil {
+		t.Errorf("Error updating IPv6 network: %s", err)
+	}
+	eas := *network.ExtensibleAttributes
+	if eas["Location"].Value.(string) != "austin" || eas["Owner"].Value.(string) != "testUser" {
+		t.Errorf("Error updating IPv6 network. EA values do not match expected values")
+	}
+	if network.PreferredLifetime == nil || *network.PreferredLifetime != lifetime {
+		t.Errorf("Error updating IPv6 network. Preferred lifetime does not match expected value")
+	}
+	testIPv6Network = network
+}
+
+func TestCreateIPv6NetworkFromContainerByEa(t *testing.T) {
+	network, err := ipv6NetworkClient.CreateIPv6NetworkFromContainer(&testIPv6NetworkFromContainerByEa)
+	if err != nil {
+		t.Errorf("Error creating IPv6 network: %s", err)
+	}
+	_, container, _ := net.ParseCIDR(testIPv6Container.CIDR)
+	ip, _, err := net.ParseCIDR(network.CIDR)
+	if err != nil || !container.Contains(ip) || network.CIDR == testIPv6Network.CIDR {
+		t.Errorf("Expected a free /64 within %s, got %s", testIPv6Container.CIDR, network.CIDR)
+	}
+	testIPv6NetworkFromContainerResolved = network
+}
+
+func TestListIPv6Networks(t *testing.T) {
+	networks, err := ipv6NetworkClient.ListIPv6Networks(map[string]string{"network_view": "default"})
+	if err != nil {
+		t.Errorf("Error listing IPv6 networks: %s", err)
+	}
+	if len(networks) < 2 {
+		t.Errorf("Expected at least 2 IPv6 networks, got %d", len(networks))
+	}
+}
+
+func TestDeleteIPv6Networks(t *testing.T) {
+	for _, ref := range []string{testIPv6Network.Ref, testIPv6NetworkFromContainerResolved.Ref} {
+		err := ipv6NetworkClient.DeleteIPv6Network(ref)
+		if err != nil {
+			t.Errorf("Error deleting IPv6 network: %s", err)
+		}
+	}
+}
+
+func TestDeleteIPv6Container(t *testing.T) {
+	err := ipv6NetworkClient.DeleteIPv6Container(testIPv6Container.Ref)
+	if err != nil {
+		t.Errorf("Error deleting IPv6 container: %s", err)
+	}
+}
+
+func TestLogoutIPv6Network(t *testing.T) {
+	err := ipv6NetworkClient.Logout()
+	if err != nil {
+		t.Errorf("Error logging out: %s", err)
+	}
+}
@@ -0,0 +1,137 @@
+package infoblox
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+const (
+	ipv6RangeBasePath     = "ipv6range"
+	ipv6RangeReturnFields = "name,network,network_view,address_type,start_addr,end_addr,ipv6_prefix_bits,ipv6_start_prefix,ipv6_end_prefix,disable,comment,extattrs,member,server_association_type"
+)
+
+// GetIPv6RangeByRef gets IPv6 range by reference
+func (c *Client) GetIPv6RangeByRef(ref string, queryParams map[string]string) (IPv6Range, error) {
+	return c.GetIPv6RangeByRefWithContext(context.Background(), ref, queryParams)
+}
+
+// GetIPv6RangeByRefWithContext gets IPv6 range by reference using the supplied context
+func (c *Client) GetIPv6RangeByRefWithContext(ctx context.Context, ref string, queryParams map[string]string) (IPv6Range, error) {
+	var ret IPv6Range
+
+	queryParams = returnFields(queryParams, ipv6RangeReturnFields)
+
+	queryParamString := c.BuildQuery(queryParams)
+	request, err := c.CreateJSONRequestWithContext(ctx, http.MethodGet, fmt.Sprintf("%s?%s", ref, queryParamString), nil)
+	if err != nil {
+		return ret, err
+	}
+
+	response := c.Call(request, &ret)
+	if response != nil {
+		return ret, response
+	}
+
+	return ret, nil
+}
+
+// GetIPv6RangeByQuery gets IPv6 range by query
+func (c *Client) GetIPv6RangeByQuery(queryParams map[string]string) ([]IPv6Range, error) {
+	return c.GetIPv6RangeByQueryWithContext(context.Background(), queryParams)
+}
+
+// GetIPv6RangeByQueryWithContext gets IPv6 range by query using the supplied context
+func (c *Client) GetIPv6RangeByQueryWithContext(ctx context.Context, queryParams map[string]string) ([]IPv6Range, error) {
+	return c.ListIPv6RangesWithContext(ctx, queryParams)
+}
+
+// ListIPv6Ranges lists all IPv6 ranges matching query parameters following every result page
+func (c *Client) ListIPv6Ranges(queryParams map[string]string) ([]IPv6Range, error) {
+	return c.ListIPv6RangesWithContext(context.Background(), queryParams)
+}
+
+// ListIPv6RangesWithContext lists all IPv6 ranges matching query parameters following every result page using the supplied context
+func (c *Client) ListIPv6RangesWithContext(ctx context.Context, queryParams map[string]string) ([]IPv6Range, error) {
+	return listWithReturnFields[IPv6Range](ctx, c, ipv6RangeBasePath, ipv6RangeReturnFields, queryParams)
+}
+
+// CreateIPv6Range creates IPv6 range
+func (c *Client) CreateIPv6Range(rangeObject *IPv6Range) error {
+	return c.CreateIPv6RangeWithContext(context.Background(), rangeObject)
+}
+
+// CreateIPv6RangeWithContext creates IPv6 range using the supplied context
+func (c *Client) CreateIPv6RangeWithContext(ctx context.Context, rangeObject *IPv6Range) error {
+	rangeObject.NetworkView = c.networkView(rangeObject.NetworkView)
+	queryParams := map[string]string{
+		"_return_fields": ipv6RangeReturnFields,
+	}
+	queryParamString := c.BuildQuery(queryParams)
+	request, err := c.CreateJSONRequestWithContext(ctx, http.MethodPost, fmt.Sprintf("%s?%s", ipv6RangeBasePath, queryParamString), rangeObject)
+	if err != nil {
+		return err
+	}
+
+	response := c.Call(request, &rangeObject)
+	if response != nil {
+		return response
+	}
+	return nil
+}
+
+// UpdateIPv6Range updates IPv6 range
+func (c *Client) UpdateIPv6Range(ref string, rangeObject IPv6Range) (IPv6Range, error) {
+	return c.UpdateIPv6RangeWithContext(context.Background(), ref, rangeObject)
+}
+
+// UpdateIPv6RangeWithContext updates IPv6 range using the supplied context
+func (c *Client) UpdateIPv6RangeWithContext(ctx context.Context, ref string, rangeObject IPv6Range) (IPv6Range, error) {
+	var ret IPv6Range
+	queryParams := map[string]string{
+		"_return_fields": ipv6RangeReturnFields,
+	}
+	queryParamString := c.BuildQuery(queryParams)
+	request, err := c.CreateJSONRequestWithContext(ctx, http.MethodPut, fmt.Sprintf("%s?%s", ref, queryParamString), rangeObject)
+	if err != nil {
+		return ret, err
+	}
+
+	response := c.Call(request, &ret)
+	if response != nil {
+		return ret, response
+	}
+	return ret, nil
+}
+
+// DeleteIPv6Range deletes IPv6 range
+func (c *Client) DeleteIPv6Range(ref string) error {
+	return c.DeleteIPv6RangeWithContext(context.Background(), ref)
+}
+
+// DeleteIPv6RangeWithContext deletes IPv6 range using the supplied context
+func (c *Client) DeleteIPv6RangeWithContext(ctx context.Context, ref string) error {
+	request, err := c.CreateJSONRequestWithContext(ctx, http.MethodDelete, ref, nil)
+	if err != nil {
+		return err
+	}
+
+	response := c.Call(request, nil)
+	if response != nil {
+		if IsNotFound(response) {
+			return nil
+		}
+		return response
+	}
+	return nil
+}
+
+// CheckIfIPv6RangeContainsRange checks if an IPv6 range exists containing ip range
+func (c *Client) CheckIfIPv6RangeContainsRange(query IPsWithinRangeQuery) (bool, error) {
+	return c.CheckIfIPv6RangeContainsRangeWithContext(context.Background(), query)
+}
+
+// CheckIfIPv6RangeContainsRangeWithContext checks if an IPv6 range exists containing ip range using the supplied context
+func (c *Client) CheckIfIPv6RangeContainsRangeWithContext(ctx context.Context, query IPsWithinRangeQuery) (bool, error) {
+	return c.rangeOverlapsExisting(ctx, ipv6RangeBasePath, query)
+}
@@ -0,0 +1,177 @@
+//go:build all || unittests
+// +build all unittests
+
+package infoblox
+
+import (
+	"testing"
+)
+
+var (
+	ipv6RangeConfig      = testConfig()
+	ipv6RangeClient      = New(ipv6RangeConfig)
+	ipv6RangeTestNetwork = IPv6Network{
+		CIDR:        "fd00:19:0:30::/64",
+		NetworkView: "default",
+		Comment:     "IPv6 range testing",
+	}
+	testIPv6Range = IPv6Range{
+		Comment:      "IPv6 range testing",
+		StartAddress: "fd00:19:0:30::100",
+		EndAddress:   "fd00:19:0:30::1ff",
+		ExtensibleAttributes: newExtensibleAttribute(ExtensibleAttribute{
+			"Owner": ExtensibleAttributeValue{
+				Value: "testUser",
+			},
+		}),
+	}
+	testIPv6FixedAddress = IPv6FixedAddress{
+		Hostname:  "test-api-ipv6-fixed",
+		IPAddress: "fd00:19:0:30::10",
+		DUID:      "00:01:00:01:2a:3b:4c:5d:00:11:22:33:44:66",
+		Comment:   "IPv6 fixed address testing",
+	}
+)
+
+func TestCreateIPv6Range(t *testing.T) {
+	err := ipv6RangeClient.CreateIPv6Network(&ipv6RangeTestNetwork)
+	if err != nil {
+		t.Errorf("Error creating IPv6 network: %s", err)
+	}
+	err = ipv6RangeClient.CreateIPv6Range(&testIPv6Range)
+	if err != nil {
+		t.Errorf("Error creating IPv6 range: %s", err)
+	}
+	if testIPv6Range.CIDR != ipv6RangeTestNetwork.CIDR {
+		t.Errorf("Expected IPv6 range network %s, got %s", ipv6RangeTestNetwork.CIDR, testIPv6Range.CIDR)
+	}
+}
+
+func TestGetIPv6Range(t *testing.T) {
+	addressRange, err := ipv6RangeClient.GetIPv6RangeByRef(testIPv6Range.Ref, nil)
+	if err != nil {
+		t.Errorf("Error retrieving IPv6 range: %s", err)
+	}
+	if addressRange.StartAddress != testIPv6Range.StartAddress || addressRange.EndAddress != testIPv6Range.EndAddress {
+		t.Errorf("Error retrieving IPv6 range. Values do not match expected values")
+	}
+	ranges, err := ipv6RangeClient.GetIPv6RangeByQuery(map[string]string{"network": ipv6RangeTestNetwork.CIDR})
+	if err != nil {
+		t.Errorf("Error querying IPv6 ranges: %s", err)
+	}
+	if len(ranges) != 1 {
+		t.Errorf("Expected 1 IPv6 range, got %d", len(ranges))
+	}
+}
+
+func TestUpdateIPv6Range(t *testing.T) {
+	updates := IPv6Range{
+		Comment: "IPv6 range testing updated",
+	}
+	addressRange, err := ipv6RangeClient.UpdateIPv6Range(testIPv6Range.Ref, updates)
+	if err != nil {
+		t.Errorf("Error updating IPv6 range: %s", err)
+	}
+	if addressRange.Comment != updates.Comment {
+		t.Errorf("Error updating IPv6 range. Comment does not match expected value")
+	}
+	testIPv6Range = addressRange
+}
+
+func TestCheckIfIPv6RangeContainsRange(t *testing.T) {
+	overlapping, err := ipv6RangeClient.CheckIfIPv6RangeContainsRange(IPsWithinRangeQuery{
+		CIDR:         ipv6RangeTestNetwork.CIDR,
+		StartAddress: "fd00:19:0:30::180",
+		EndAddress:   "fd00:19:0:30::2ff",
+	})
+	if err != nil {
+		t.Errorf("Error checking IPv6 range overlap: %s", err)
+	}
+	if !overlapping {
+		t.Errorf("Expected fd00:19:0:30::180-fd00:19:0:30::2ff to overlap existing range")
+	}
+	overlapping, err = ipv6RangeClient.CheckIfIPv6RangeContainsRange(IPsWithinRangeQuery{
+		CIDR:         ipv6RangeTestNetwork.CIDR,
+		StartAddress: "fd00:19:0:30::200",
+		EndAddress:   "fd00:19:0:30::2ff",
+	})
+	if err != nil {
+		t.Errorf("Error checking IPv6 range overlap: %s", err)
+	}
+	if overlapping {
+		t.Errorf("Expected fd00:19:0:30::200-fd00:19:0:30::2ff not to overlap existing range")
+	}
+}
+
+func TestCreateIPv6FixedAddress(t *testing.T) {
+	err := ipv6RangeClient.CreateIPv6FixedAddress(&testIPv6FixedAddress)
+	if err != nil {
+		t.Errorf("Error creating IPv6 fixed address: %s", err)
+	}
+	fixedAddresses, err := ipv6RangeClient.GetIPv6FixedAddressByQuery(map[string]string{"duid": testIPv6FixedAddress.DUID})
+	if err != nil {
+		t.Errorf("Error querying IPv6 fixed addresses: %s", err)
+	}
+	if len(fixedAddresses) != 1 || fixedAddresses[0].CIDR != ipv6RangeTestNetwork.CIDR {
+		t.Errorf("Expected 1 IPv6 fixed address within %s", ipv6RangeTestNetwork.CIDR)
+	}
+}
+
+func TestGetUsedIPv6AddressesWithinRange(t *testing.T) {
+	addresses, err := ipv6RangeClient.GetUsedIPv6AddressesWithinRange(AddressQuery{
+		CIDR:         ipv6RangeTestNetwork.CIDR,
+		StartAddress: "fd00:19:0:30::1",
+		EndAddress:   "fd00:19:0:30::ff",
+	})
+	if err != nil {
+		t.Errorf("Error retrieving used IPv6 addresses: %s", err)
+	}
+	if len(*addresses) != 1 {
+		t.Fatalf("Expected 1 used IPv6 address, got %d", len(*addresses))
+	}
+	if (*addresses)[0].IPAddress != testIPv6FixedAddress.IPAddress || (*addresses)[0].DUID != testIPv6FixedAddress.DUID {
+		t.Errorf("Expected used address %s, got %s", testIPv6FixedAddress.IPAddress, (*addresses)[0].IPAddress)
+	}
+}
+
+func TestGetUnusedIPv6AddressesWithinRange(t *testing.T) {
+	addresses, err := ipv6RangeClient.GetUnusedIPv6AddressesWithinRange(AddressQuery{
+		CIDR:         ipv6RangeTestNetwork.CIDR,
+		StartAddress: "fd00:19:0:30::e",
+		EndAddress:   "fd00:19:0:30::ff",
+		Count:        4,
+	})
+	if err != nil {
+		t.Errorf("Error retrieving unused IPv6 addresses: %s", err)
+	}
+	if len(*addresses) != 4 {
+		t.Fatalf("Expected 4 unused IPv6 addresses, got %d", len(*addresses))
+	}
+	for _, address := range *addresses {
+		if address.IPAddress == testIPv6FixedAddress.IPAddress {
+			t.Errorf("Expected %s to be excluded from unused addresses", address.IPAddress)
+		}
+	}
+}
+
+func TestDeleteIPv6Range(t *testing.T) {
+	err := ipv6RangeClient.DeleteIPv6FixedAddress(testIPv6FixedAddress.Ref)
+	if err != nil {
+		t.Errorf("Error deleting IPv6 fixed address: %s", err)
+	}
+	err = ipv6RangeClient.DeleteIPv6Range(testIPv6Range.Ref)
+	if err != nil {
+		t.Errorf("Error deleting IPv6 range: %s", err)
+	}
+	err = ipv6RangeClient.DeleteIPv6Network(ipv6RangeTestNetwork.Ref)
+	if err != nil {
+		t.Errorf("Error deleting IPv6 network: %s", err)
+	}
+}
+
+func TestLogoutIPv6Range(t *testing.T) {
+	err := ipv6RangeClient.Logout()
+	if err != nil {
+		t.Errorf("Error logging out: %s", err)
+	}
+}
@@ -0,0 +1,81 @@
+package infoblox
+
+import (
+	"context"
+	"errors"
+)
+
+const (
+	ipv6AddressBasePath     = "ipv6address"
+	ipv6AddressReturnFields = "ip_address,duid,network,network_view,status,names,objects,types,usage"
+)
+
+// GetUsedIPv6AddressesWithinRange gets used IPv6 addresses within selected network range
+func (c *Client) GetUsedIPv6AddressesWithinRange(query AddressQuery) (*[]IPv6Address, error) {
+	return c.GetUsedIPv6AddressesWithinRangeWithContext(context.Background(), query)
+}
+
+// GetUsedIPv6AddressesWithinRangeWithContext gets used IPv6 addresses within selected network range using the supplied context
+func (c *Client) GetUsedIPv6AddressesWithinRangeWithContext(ctx context.Context, query AddressQuery) (*[]IPv6Address, error) {
+	var filteredResults []IPv6Address
+
+	query.fillDefaults(c.config.NetworkView)
+	err := ForEach(ctx, c, ipv6AddressBasePath, query.ipv6AddressParams(), c.pageSize(), func(result IPv6Address) error {
+		if result.Status != "USED" {
+			return nil
+		}
+		if *query.FilterEmptyHostnames && len(result.Hostnames) == 0 && len(result.Objects) == 0 {
+			return nil
+		}
+		filteredResults = append(filteredResults, result)
+		return nil
+	})
+	if err != nil {
+		return &filteredResults, err
+	}
+	return &filteredResults, nil
+}
+
+// GetUnusedIPv6AddressesWithinRange gets up to query.Count unused IPv6 addresses within
+// selected network range.  A count of 0 returns every unused address in the range
+func (c *Client) GetUnusedIPv6AddressesWithinRange(query AddressQuery) (*[]IPv6Address, error) {
+	return c.GetUnusedIPv6AddressesWithinRangeWithContext(context.Background(), query)
+}
+
+// GetUnusedIPv6AddressesWithinRangeWithContext gets up to query.Count unused IPv6 addresses within
+// selected network range using the supplied context
+func (c *Client) GetUnusedIPv6AddressesWithinRangeWithContext(ctx context.Context, query AddressQuery) (*[]IPv6Address, error) {
+	var addresses []IPv6Address
+	errCountReached := errors.New("address count reached")
+
+	query.fillDefaults(c.config.NetworkView)
+	queryParams := query.ipv6AddressParams()
+	queryParams["status"] = "UNUSED"
+	err := ForEach(ctx, c, ipv6AddressBasePath, queryParams, c.pageSize(), func(result IPv6Address) error {
+		addresses = append(addresses, result)
+		if query.Count > 0 && len(addresses) >= query.Count {
+			return errCountReached
+		}
+		return nil
+	})
+	if err != nil && err != errCountReached {
+		return &addresses, err
+	}
+	return &addresses, nil
+}
+
+// ipv6AddressParams builds ipv6address search parameters for the query bounds
+func (aq *AddressQuery) ipv6AddressParams() map[string]string {
+	queryParams := map[string]string{
+		"network":        aq.CIDR,
+		"network_view":   aq.NetworkView,
+		"_return_fields": ipv6AddressReturnFields,
+	}
+	if aq.StartAddress != "" {
+		queryParams["ip_address>"] = aq.StartAddress
+	}
+	if aq.EndAddress != "" {
+		queryParams["ip_address<"] = aq.EndAddress
+	}
+	return queryParams
+}
@@ -0,0 +1,127 @@
+package infoblox
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+const (
+	mxRecordBasePath     = "record:mx"
+	mxRecordReturnFields = "mail_exchanger,preference,name,view,dns_name,dns_mail_exchanger,disable,comment,zone,extattrs"
+)
+
+// GetMXRecordByRef gets MX record by reference
+func (c *Client) GetMXRecordByRef(ref string, queryParams map[string]string) (MXRecord, error) {
+	return c.GetMXRecordByRefWithContext(context.Background(), ref, queryParams)
+}
+
+// GetMXRecordByRefWithContext gets MX record by reference using the supplied context
+func (c *Client) GetMXRecordByRefWithContext(ctx context.Context, ref string, queryParams map[string]string) (MXRecord, error) {
+	var ret MXRecord
+	queryParams = returnFields(queryParams, mxRecordReturnFields)
+
+	queryParamString := c.BuildQuery(queryParams)
+
+	request, err := c.CreateJSONRequestWithContext(ctx, http.MethodGet, fmt.Sprintf("%s?%s", ref, queryParamString), nil)
+	if err != nil {
+		return ret, err
+	}
+
+	response := c.Call(request, &ret)
+	if response != nil {
+		return ret, response
+	}
+
+	return ret, nil
+}
+
+// GetMXRecordByQuery gets MX records by query parameters
+func (c *Client) GetMXRecordByQuery(queryParams map[string]string) ([]MXRecord, error) {
+	return c.GetMXRecordByQueryWithContext(context.Background(), queryParams)
+}
+
+// GetMXRecordByQueryWithContext gets MX records by query parameters using the supplied context
+func (c *Client) GetMXRecordByQueryWithContext(ctx context.Context, queryParams map[string]string) ([]MXRecord, error) {
+	return c.ListMXRecordsWithContext(ctx, queryParams)
+}
+
+// ListMXRecords lists all MX records matching query parameters following every result page
+func (c *Client) ListMXRecords(queryParams map[string]string) ([]MXRecord, error) {
+	return c.ListMXRecordsWithContext(context.Background(), queryParams)
+}
+
+// ListMXRecordsWithContext lists all MX records matching query parameters following every result page using the supplied context
+func (c *Client) ListMXRecordsWithContext(ctx context.Context, queryParams map[string]string) ([]MXRecord, error) {
+	return listWithReturnFields[MXRecord](ctx, c, mxRecordBasePath, mxRecordReturnFields, queryParams)
+}
+
+// CreateMXRecord creates MX record
+func (c *Client) CreateMXRecord(record *MXRecord) error {
+	return c.CreateMXRecordWithContext(context.Background(), record)
+}
+
+// CreateMXRecordWithContext creates MX record using the supplied context
+func (c *Client) CreateMXRecordWithContext(ctx context.Context, record *MXRecord) error {
+	record.View = c.dnsView(record.View)
+	queryParams := map[string]string{
+		"_return_fields": mxRecordReturnFields,
+	}
+	queryParamString := c.BuildQuery(queryParams)
+	request, err := c.CreateJSONRequestWithContext(ctx, http.MethodPost, fmt.Sprintf("%s?%s", mxRecordBasePath, queryParamString), record)
+	if err != nil {
+		return err
+	}
+
+	response := c.Call(request, &record)
+	if response != nil {
+		return response
+	}
+	return nil
+}
+
+// UpdateMXRecord updates MX record
+func (c *Client) UpdateMXRecord(ref string, record MXRecord) (MXRecord, error) {
+	return c.UpdateMXRecordWithContext(context.Background(), ref, record)
+}
+
+// UpdateMXRecordWithContext updates MX record using the supplied context
+func (c *Client) UpdateMXRecordWithContext(ctx context.Context, ref string, record MXRecord) (MXRecord, error) {
+	var ret MXRecord
+	queryParams := map[string]string{
+		"_return_fields": mxRecordReturnFields,
+	}
+	queryParamString := c.BuildQuery(queryParams)
+	request, err := c.CreateJSONRequestWithContext(ctx, http.MethodPut, fmt.Sprintf("%s?%s", ref, queryParamString), record)
+	if err != nil {
+		return ret, err
+	}
+
+	response := c.Call(request, &ret)
+	if response != nil {
+		return ret, response
+	}
+	return ret, nil
+}
+
+// DeleteMXRecord deletes MX record
+func (c *Client) DeleteMXRecord(ref string) error {
+	return c.DeleteMXRecordWithContext(context.Background(), ref)
+}
+
+// DeleteMXRecordWithContext deletes MX record using the supplied context
+func (c *Client) DeleteMXRecordWithContext(ctx context.Context, ref string) error {
+	request, err := c.CreateJSONRequestWithContext(ctx, http.MethodDelete, fmt.Sprintf("%s", ref), nil)
+	if err != nil {
+		return err
+	}
+
+	response := c.Call(request, nil)
+	if response != nil {
+		if IsNotFound(response) {
+			return nil
+		}
+		return response
+	}
+	return nil
+}
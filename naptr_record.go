@@ -0,0 +1,154 @@
+package infoblox
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"regexp"
+)
+
+const (
+	naptrRecordBasePath     = "record:naptr"
+	naptrRecordReturnFields = "name,order,preference,flags,services,regexp,replacement,view,dns_name,dns_replacement,disable,comment,zone,extattrs"
+)
+
+// GetNAPTRRecordByRef gets NAPTR record by reference
+func (c *Client) GetNAPTRRecordByRef(ref string, queryParams map[string]string) (NAPTRRecord, error) {
+	return c.GetNAPTRRecordByRefWithContext(context.Background(), ref, queryParams)
+}
+
+// GetNAPTRRecordByRefWithContext gets NAPTR record by reference using the supplied context
+func (c *Client) GetNAPTRRecordByRefWithContext(ctx context.Context, ref string, queryParams map[string]string) (NAPTRRecord, error) {
+	var ret NAPTRRecord
+	queryParams = returnFields(queryParams, naptrRecordReturnFields)
+
+	queryParamString := c.BuildQuery(queryParams)
+
+	request, err := c.CreateJSONRequestWithContext(ctx, http.MethodGet, fmt.Sprintf("%s?%s", ref, queryParamString), nil)
+	if err != nil {
+		return ret, err
+	}
+
+	response := c.Call(request, &ret)
+	if response != nil {
+		return ret, response
+	}
+
+	return ret, nil
+}
+
+// GetNAPTRRecordByQuery gets NAPTR records by query parameters
+func (c *Client) GetNAPTRRecordByQuery(queryParams map[string]string) ([]NAPTRRecord, error) {
+	return c.GetNAPTRRecordByQueryWithContext(context.Background(), queryParams)
+}
+
+// GetNAPTRRecordByQueryWithContext gets NAPTR records by query parameters using the supplied context
+func (c *Client) GetNAPTRRecordByQueryWithContext(ctx context.Context, queryParams map[string]string) ([]NAPTRRecord, error) {
+	return c.ListNAPTRRecordsWithContext(ctx, queryParams)
+}
+
+// ListNAPTRRecords lists all NAPTR records matching query parameters following every result page
+func (c *Client) ListNAPTRRecords(queryParams map[string]string) ([]NAPTRRecord, error) {
+	return c.ListNAPTRRecordsWithContext(context.Background(), queryParams)
+}
+
+// ListNAPTRRecordsWithContext lists all NAPTR records matching query parameters following every result page using the supplied context
+func (c *Client) ListNAPTRRecordsWithContext(ctx context.Context, queryParams map[string]string) ([]NAPTRRecord, error) {
+	return listWithReturnFields[NAPTRRecord](ctx, c, naptrRecordBasePath, naptrRecordReturnFields, queryParams)
+}
+
+// CreateNAPTRRecord creates NAPTR record
+func (c *Client) CreateNAPTRRecord(record *NAPTRRecord) error {
+	return c.CreateNAPTRRecordWithContext(context.Background(), record)
+}
+
+// CreateNAPTRRecordWithContext creates NAPTR record using the supplied context
+func (c *Client) CreateNAPTRRecordWithContext(ctx context.Context, record *NAPTRRecord) error {
+	record.View = c.dnsView(record.View)
+	if err := record.Validate(); err != nil {
+		return err
+	}
+	queryParams := map[string]string{
+		"_return_fields": naptrRecordReturnFields,
+	}
+	queryParamString := c.BuildQuery(queryParams)
+	request, err := c.CreateJSONRequestWithContext(ctx, http.MethodPost, fmt.Sprintf("%s?%s", naptrRecordBasePath, queryParamString), record)
+	if err != nil {
+		return err
+	}
+
+	response := c.Call(request, &record)
+	if response != nil {
+		return response
+	}
+	return nil
+}
+
+// UpdateNAPTRRecord updates NAPTR record
+func (c *Client) UpdateNAPTRRecord(ref string, record NAPTRRecord) (NAPTRRecord, error) {
+	return c.UpdateNAPTRRecordWithContext(context.Background(), ref, record)
+}
+
+// UpdateNAPTRRecordWithContext updates NAPTR record using the supplied context
+func (c *Client) UpdateNAPTRRecordWithContext(ctx context.Context, ref string, record NAPTRRecord) (NAPTRRecord, error) {
+	var ret NAPTRRecord
+	if err := record.Validate(); err != nil {
+		return ret, err
+	}
+	queryParams := map[string]string{
+		"_return_fields": naptrRecordReturnFields,
+	}
+	queryParamString := c.BuildQuery(queryParams)
+	request, err := c.CreateJSONRequestWithContext(ctx, http.MethodPut, fmt.Sprintf("%s?%s", ref, queryParamString), record)
+	if err != nil {
+		return ret, err
+	}
+
+	response := c.Call(request, &ret)
+	if response != nil {
+		return ret, response
+	}
+	return ret, nil
+}
+
+// DeleteNAPTRRecord deletes NAPTR record
+func (c *Client) DeleteNAPTRRecord(ref string) error {
+	return c.DeleteNAPTRRecordWithContext(context.Background(), ref)
+}
+
+// DeleteNAPTRRecordWithContext deletes NAPTR record using the supplied context
+func (c *Client) DeleteNAPTRRecordWithContext(ctx context.Context, ref string) error {
+	request, err := c.CreateJSONRequestWithContext(ctx, http.MethodDelete, fmt.Sprintf("%s", ref), nil)
+	if err != nil {
+		return err
+	}
+
+	response := c.Call(request, nil)
+	if response != nil {
+		if IsNotFound(response) {
+			return nil
+		}
+		return response
+	}
+	return nil
+}
+
+var naptrFlagsPattern = regexp.MustCompile(`^[A-Za-z0-9]*$`)
+
+// Validate checks the NAPTR order, preference and flags formats and that
+// regexp and replacement are not both set
+func (r NAPTRRecord) Validate() error {
+	if err := validateIntRange("NAPTR order", r.Order, 0, 65535); err != nil {
+		return err
+	}
+	if err := validateIntRange("NAPTR preference", r.Preference, 0, 65535); err != nil {
+		return err
+	}
+	if r.Flags != nil && !naptrFlagsPattern.MatchString(*r.Flags) {
+		return fmt.Errorf("%w: NAPTR flags %q must be alphanumeric", ErrValidation, *r.Flags)
+	}
+	if r.Regexp != nil && *r.Regexp != "" && r.Replacement != "" && r.Replacement != "." {
+		return fmt.Errorf("%w: NAPTR regexp and replacement are mutually exclusive", ErrValidation)
+	}
+	return nil
+}
@@ -1,6 +1,7 @@
 package infoblox
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 )
@@ -12,25 +13,24 @@ const (
 
 // GetNetworkByRef gets network by reference
 func (c *Client) GetNetworkByRef(ref string, queryParams map[string]string) (Network, error) {
+	return c.GetNetworkByRefWithContext(context.Background(), ref, queryParams)
+}
+
+// GetNetworkByRefWithContext gets network by reference using the supplied context
+func (c *Client) GetNetworkByRefWithContext(ctx context.Context, ref string, queryParams map[string]string) (Network, error) {
 	var ret Network
-	if queryParams == nil {
-		queryParams = map[string]string{
-			"_return_fields": networkReturnFields,
-		}
-	} else {
-		queryParams["_return_fields"] = networkReturnFields
-	}
+	queryParams = returnFields(queryParams, networkReturnFields)
 
 	queryParamString := c.BuildQuery(queryParams)
 
-	request, err := c.CreateJSONRequest(http.MethodGet, fmt.Sprintf("%s?%s", ref, queryParamString), nil)
+	request, err := c.CreateJSONRequestWithContext(ctx, http.MethodGet, fmt.Sprintf("%s?%s", ref, queryParamString), nil)
 	if err != nil {
 		return ret, err
 	}
 
 	response := c.Call(request, &ret)
 	if response != nil {
-		return ret, fmt.Errorf(response.ErrorMessage)
+		return ret, response
 	}
 
 	return ret, nil
@@ -38,53 +38,63 @@ func (c *Client) GetNetworkByRef(ref string, queryParams map[string]string) (Net
 
 // GetNetworkByQuery gets network by query parameters
 func (c *Client) GetNetworkByQuery(queryParams map[string]string) ([]Network, error) {
-	var ret NetworkQueryResult
-	queryParams["_return_fields"] = networkReturnFields
-	queryParams["_return_as_object"] = "1"
-	queryParams["_paging"] = "0"
-	queryParams["_max_results"] = "2"
+	return c.GetNetworkByQueryWithContext(context.Background(), queryParams)
+}
 
-	queryParamString := c.BuildQuery(queryParams)
-	request, err := c.CreateJSONRequest(http.MethodGet, fmt.Sprintf("%s?%s", networkBasePath, queryParamString), nil)
-	if err != nil {
-		return nil, err
-	}
+// GetNetworkByQueryWithContext gets network by query parameters using the supplied context
+func (c *Client) GetNetworkByQueryWithContext(ctx context.Context, queryParams map[string]string) ([]Network, error) {
+	return c.ListNetworksWithContext(ctx, queryParams)
+}
 
-	response := c.Call(request, &ret)
-	if response != nil {
-		return nil, fmt.Errorf(response.ErrorMessage)
-	}
+// ListNetworks lists all networks matching query parameters following every result page
+func (c *Client) ListNetworks(queryParams map[string]string) ([]Network, error) {
+	return c.ListNetworksWithContext(context.Background(), queryParams)
+}
 
-	return ret.Results, nil
+// ListNetworksWithContext lists all networks matching query parameters following every result page using the supplied context
+func (c *Client) ListNetworksWithContext(ctx context.Context, queryParams map[string]string) ([]Network, error) {
+	return listWithReturnFields[Network](ctx, c, networkBasePath, networkReturnFields, queryParams)
 }
 
 // CreateNetwork creates network
 func (c *Client) CreateNetwork(network *Network) error {
+	return c.CreateNetworkWithContext(context.Background(), network)
+}
+
+// CreateNetworkWithContext creates network using the supplied context
+func (c *Client) CreateNetworkWithContext(ctx context.Context, network *Network) error {
+	network.NetworkView = c.networkView(network.NetworkView)
 	queryParams := map[string]string{
 		"_return_fields": networkReturnFields,
 	}
 	queryParamString := c.BuildQuery(queryParams)
-	request, err := c.CreateJSONRequest(http.MethodPost, fmt.Sprintf("%s?%s", networkBasePath, queryParamString), network)
+	request, err := c.CreateJSONRequestWithContext(ctx, http.MethodPost, fmt.Sprintf("%s?%s", networkBasePath, queryParamString), network)
 	if err != nil {
 		return err
 	}
 
 	response := c.Call(request, &network)
 	if response != nil {
-		return fmt.Errorf(response.ErrorMessage)
+		return response
 	}
 	return nil
 }
 
 // CreateNetworkFromContainer creates network
 func (c *Client) CreateNetworkFromContainer(container *NetworkFromContainer) (Network, error) {
+	return c.CreateNetworkFromContainerWithContext(context.Background(), container)
+}
+
+// CreateNetworkFromContainerWithContext creates network using the supplied context
+func (c *Client) CreateNetworkFromContainerWithContext(ctx context.Context, container *NetworkFromContainer) (Network, error) {
+	container.NetworkView = c.networkView(container.NetworkView)
 	var ret Network
 	queryParams := map[string]string{
 		"_return_fields":    networkReturnFields,
 		"_return_as_object": "1",
 	}
 	queryParamString := c.BuildQuery(queryParams)
-	request, err := c.CreateJSONRequest(http.MethodPost, fmt.Sprintf("%s?%s", networkBasePath, queryParamString), container)
+	request, err := c.CreateJSONRequestWithContext(ctx, http.MethodPost, fmt.Sprintf("%s?%s", networkBasePath, queryParamString), container)
 	if err != nil {
 		return ret, err
 	}
@@ -92,11 +102,11 @@ func (c *Client) CreateNetworkFromContainer(container *NetworkFromContainer) (Ne
 	var result NetworkFromContainerResult
 	response := c.Call(request, &result)
 	if response != nil {
-		return ret, fmt.Errorf(response.ErrorMessage)
+		return ret, response
 	}
-	ret, err = c.GetNetworkByRef(result.Result.Ref, nil)
+	ret, err = c.GetNetworkByRefWithContext(ctx, result.Result.Ref, nil)
 	if err != nil {
-		return ret, fmt.Errorf(response.ErrorMessage)
+		return ret, err
 	}
 
 	return ret, nil
@@ -104,36 +114,46 @@ func (c *Client) CreateNetworkFromContainer(container *NetworkFromContainer) (Ne
 
 // UpdateNetwork updates network
 func (c *Client) UpdateNetwork(ref string, network Network) (Network, error) {
+	return c.UpdateNetworkWithContext(context.Background(), ref, network)
+}
+
+// UpdateNetworkWithContext updates network using the supplied context
+func (c *Client) UpdateNetworkWithContext(ctx context.Context, ref string, network Network) (Network, error) {
 	var ret Network
 	queryParams := map[string]string{
 		"_return_fields": networkReturnFields,
 	}
 	queryParamString := c.BuildQuery(queryParams)
-	request, err := c.CreateJSONRequest(http.MethodPut, fmt.Sprintf("%s?%s", ref, queryParamString), network)
+	request, err := c.CreateJSONRequestWithContext(ctx, http.MethodPut, fmt.Sprintf("%s?%s", ref, queryParamString), network)
 	if err != nil {
 		return ret, err
 	}
 
 	response := c.Call(request, &ret)
 	if response != nil {
-		return ret, fmt.Errorf(response.ErrorMessage)
+		return ret, response
 	}
 	return ret, nil
 }
 
 // DeleteNetwork deletes network
 func (c *Client) DeleteNetwork(ref string) error {
-	request, err := c.CreateJSONRequest(http.MethodDelete, ref, nil)
+	return c.DeleteNetworkWithContext(context.Background(), ref)
+}
+
+// DeleteNetworkWithContext deletes network using the supplied context
+func (c *Client) DeleteNetworkWithContext(ctx context.Context, ref string) error {
+	request, err := c.CreateJSONRequestWithContext(ctx, http.MethodDelete, ref, nil)
 	if err != nil {
 		return err
 	}
 
 	response := c.Call(request, nil)
 	if response != nil {
-		if response.StatusCode == 404 {
+		if IsNotFound(response) {
 			return nil
 		}
-		return err
+		return response
 	}
 	return nil
 }
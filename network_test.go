@@ -4,19 +4,11 @@
 package infoblox
 
 import (
-	"os"
 	"testing"
 )
 
 var (
-	networkConfig = Config{
-		Host:                   os.Getenv("INFOBLOX_HOST"),
-		Port:                   os.Getenv("INFOBLOX_PORT"),
-		Username:               os.Getenv("INFOBLOX_USERNAME"),
-		Password:               os.Getenv("INFOBLOX_PASSWORD"),
-		Version:                os.Getenv("INFOBLOX_VERSION"),
-		DisableTLSVerification: true,
-	}
+	networkConfig = testConfig()
 	networkClient = New(networkConfig)
 	testNetwork   = Network{
 		CIDR:        "172.19.10.0/24",
@@ -87,6 +79,7 @@ func TestGetNetwork(t *testing.T) {
 	if err != nil {
 		t.Errorf("Error retrieving network: %s", err)
 	}
+	prettyPrint(network)
 }
 
 func TestUpdateNetwork(t *testing.T) {
@@ -129,6 +122,7 @@ func TestGetNetworkCreatedFromContainer(t *testing.T) {
 	if err != nil {
 		t.Errorf("Error retrieving network: %s", err)
 	}
+	prettyPrint(network)
 }
 
 func TestUpdateNetworkCreatedFromContainer(t *testing.T) {
@@ -170,6 +164,7 @@ func TestGetNetworkCreatedFromContainerByEa(t *testing.T) {
 	if err != nil {
 		t.Errorf("Error retrieving network: %s", err)
 	}
+	prettyPrint(network)
 }
 
 func TestUpdateNetworkCreatedFromContainerByEa(t *testing.T) {
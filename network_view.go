@@ -0,0 +1,126 @@
+package infoblox
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+const (
+	networkViewBasePath     = "networkview"
+	networkViewReturnFields = "name,comment,is_default,associated_dns_views,extattrs"
+)
+
+// GetNetworkViewByRef gets network view by reference
+func (c *Client) GetNetworkViewByRef(ref string, queryParams map[string]string) (NetworkView, error) {
+	return c.GetNetworkViewByRefWithContext(context.Background(), ref, queryParams)
+}
+
+// GetNetworkViewByRefWithContext gets network view by reference using the supplied context
+func (c *Client) GetNetworkViewByRefWithContext(ctx context.Context, ref string, queryParams map[string]string) (NetworkView, error) {
+	var ret NetworkView
+	queryParams = returnFields(queryParams, networkViewReturnFields)
+
+	queryParamString := c.BuildQuery(queryParams)
+
+	request, err := c.CreateJSONRequestWithContext(ctx, http.MethodGet, fmt.Sprintf("%s?%s", ref, queryParamString), nil)
+	if err != nil {
+		return ret, err
+	}
+
+	response := c.Call(request, &ret)
+	if response != nil {
+		return ret, response
+	}
+
+	return ret, nil
+}
+
+// GetNetworkViewByQuery gets network views by query parameters
+func (c *Client) GetNetworkViewByQuery(queryParams map[string]string) ([]NetworkView, error) {
+	return c.GetNetworkViewByQueryWithContext(context.Background(), queryParams)
+}
+
+// GetNetworkViewByQueryWithContext gets network views by query parameters using the supplied context
+func (c *Client) GetNetworkViewByQueryWithContext(ctx context.Context, queryParams map[string]string) ([]NetworkView, error) {
+	return c.ListNetworkViewsWithContext(ctx, queryParams)
+}
+
+// ListNetworkViews lists all network views matching query parameters following every result page
+func (c *Client) ListNetworkViews(queryParams map[string]string) ([]NetworkView, error) {
+	return c.ListNetworkViewsWithContext(context.Background(), queryParams)
+}
+
+// ListNetworkViewsWithContext lists all network views matching query parameters following every result page using the supplied context
+func (c *Client) ListNetworkViewsWithContext(ctx context.Context, queryParams map[string]string) ([]NetworkView, error) {
+	return listWithReturnFields[NetworkView](ctx, c, networkViewBasePath, networkViewReturnFields, queryParams)
+}
+
+// CreateNetworkView creates network view
+func (c *Client) CreateNetworkView(view *NetworkView) error {
+	return c.CreateNetworkViewWithContext(context.Background(), view)
+}
+
+// CreateNetworkViewWithContext creates network view using the supplied context
+func (c *Client) CreateNetworkViewWithContext(ctx context.Context, view *NetworkView) error {
+	queryParams := map[string]string{
+		"_return_fields": networkViewReturnFields,
+	}
+	queryParamString := c.BuildQuery(queryParams)
+	request, err := c.CreateJSONRequestWithContext(ctx, http.MethodPost, fmt.Sprintf("%s?%s", networkViewBasePath, queryParamString), view)
+	if err != nil {
+		return err
+	}
+
+	response := c.Call(request, &view)
+	if response != nil {
+		return response
+	}
+	return nil
+}
+
+// UpdateNetworkView updates network view
+func (c *Client) UpdateNetworkView(ref string, view NetworkView) (NetworkView, error) {
+	return c.UpdateNetworkViewWithContext(context.Background(), ref, view)
+}
+
+// UpdateNetworkViewWithContext updates network view using the supplied context
+func (c *Client) UpdateNetworkViewWithContext(ctx context.Context, ref string, view NetworkView) (NetworkView, error) {
+	var ret NetworkView
+	queryParams := map[string]string{
+		"_return_fields": networkViewReturnFields,
+	}
+	queryParamString := c.BuildQuery(queryParams)
+	request, err := c.CreateJSONRequestWithContext(ctx, http.MethodPut, fmt.Sprintf("%s?%s", ref, queryParamString), view)
+	if err != nil {
+		return ret, err
+	}
+
+	response := c.Call(request, &ret)
+	if response != nil {
+		return ret, response
+	}
+	return ret, nil
+}
+
+// DeleteNetworkView deletes network view
+func (c *Client) DeleteNetworkView(ref string) error {
+	return c.DeleteNetworkViewWithContext(context.Background(), ref)
+}
+
+// DeleteNetworkViewWithContext deletes network view using the supplied context
+func (c *Client) DeleteNetworkViewWithContext(ctx context.Context, ref string) error {
+	request, err := c.CreateJSONRequestWithContext(ctx, http.MethodDelete, fmt.Sprintf("%s", ref), nil)
+	if err != nil {
+		return err
+	}
+
+	response := c.Call(request, nil)
+	if response != nil {
+		if IsNotFound(response) {
+			return nil
+		}
+		return response
+	}
+	return nil
+}
@@ -0,0 +1,350 @@
+package infoblox
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+const (
+	nextAvailableIPFunction = "next_available_ip"
+	nextAvailableIPPrefix   = "func:nextavailableip:"
+)
+
+// objectFunction is a WAPI _object_function field value evaluated by the grid
+// when the object is created
+type objectFunction struct {
+	Function         string                 `json:"_object_function"`
+	ResultField      string                 `json:"_result_field"`
+	Object           string                 `json:"_object"`
+	ObjectParameters map[string]interface{} `json:"_object_parameters"`
+	Parameters       map[string]interface{} `json:"_parameters,omitempty"`
+}
+
+// NextAvailableIPInNetwork allocates from the ipv4 or ipv6 network cidr.  An
+// empty network view uses the client default network view
+func NextAvailableIPInNetwork(cidr string, networkView string) NextAvailableIP {
+	object := networkBasePath
+	if strings.Contains(cidr, ":") {
+		object = ipv6NetworkBasePath
+	}
+	return NextAvailableIP{
+		Object: object,
+		ObjectParameters: map[string]string{
+			"network":      cidr,
+			"network_view": networkView,
+		},
+	}
+}
+
+// NextAvailableIPInNetworkByEA allocates from the first ipv4 network whose
+// extensible attributes match every value in eas
+func NextAvailableIPInNetworkByEA(eas map[string]string, networkView string) NextAvailableIP {
+	parameters := map[string]string{
+		"network_view": networkView,
+	}
+	for name, value := range eas {
+		parameters["*"+name] = value
+	}
+	return NextAvailableIP{
+		Object:           networkBasePath,
+		ObjectParameters: parameters,
+	}
+}
+
+// NextAvailableIPInRange allocates from the ipv4 or ipv6 range or network referenced by ref
+func NextAvailableIPInRange(ref string) NextAvailableIP {
+	return NextAvailableIP{
+		Ref: ref,
+	}
+}
+
+// WithExclude returns a copy of the allocation that skips addresses
+func (n NextAvailableIP) WithExclude(addresses ...string) NextAvailableIP {
+	n.Exclude = append(append([]string{}, n.Exclude...), addresses...)
+	return n
+}
+
+// CreateARecordWithNextAvailableIP creates A record with the next available
+// address of a network or range.  The allocated address is set on record
+func (c *Client) CreateARecordWithNextAvailableIP(record *ARecord, allocation NextAvailableIP) error {
+	return c.CreateARecordWithNextAvailableIPWithContext(context.Background(), record, allocation)
+}
+
+// CreateARecordWithNextAvailableIPWithContext creates A record with the next available
+// address of a network or range using the supplied context
+func (c *Client) CreateARecordWithNextAvailableIPWithContext(ctx context.Context, record *ARecord, allocation NextAvailableIP) error {
+	record.View = c.dnsView(record.View)
+	return c.createWithNextAvailableIP(ctx, aRecordBasePath, aRecordReturnFields, record, "ipv4addr", allocation)
+}
+
+// CreateAAAARecordWithNextAvailableIP creates AAAA record with the next available
+// address of a network or range.  The allocated address is set on record
+func (c *Client) CreateAAAARecordWithNextAvailableIP(record *AAAARecord, allocation NextAvailableIP) error {
+	return c.CreateAAAARecordWithNextAvailableIPWithContext(context.Background(), record, allocation)
+}
+
+// CreateAAAARecordWithNextAvailableIPWithContext creates AAAA record with the next available
+// address of a network or range using the supplied context
+func (c *Client) CreateAAAARecordWithNextAvailableIPWithContext(ctx context.Context, record *AAAARecord, allocation NextAvailableIP) error {
+	record.View = c.dnsView(record.View)
+	return c.createWithNextAvailableIP(ctx, aaaaRecordBasePath, aaaaRecordReturnFields, record, "ipv6addr", allocation)
+}
+
+// CreateFixedAddressWithNextAvailableIP creates fixed address with the next available
+// address of a network or range.  The allocated address is set on fixedAddress
+func (c *Client) CreateFixedAddressWithNextAvailableIP(fixedAddress *FixedAddress, allocation NextAvailableIP) error {
+	return c.CreateFixedAddressWithNextAvailableIPWithContext(context.Background(), fixedAddress, allocation)
+}
+
+// CreateFixedAddressWithNextAvailableIPWithContext creates fixed address with the next available
+// address of a network or range using the supplied context
+func (c *Client) CreateFixedAddressWithNextAvailableIPWithContext(ctx context.Context, fixedAddress *FixedAddress, allocation NextAvailableIP) error {
+	fixedAddress.NetworkView = c.networkView(fixedAddress.NetworkView)
+	return c.createWithNextAvailableIP(ctx, fixedAddressBasePath, fixedAddressReturnFields, fixedAddress, "ipv4addr", allocation)
+}
+
+// CreateIPv6FixedAddressWithNextAvailableIP creates ipv6 fixed address with the next available
+// address of a network or range.  The allocated address is set on fixedAddress
+func (c *Client) CreateIPv6FixedAddressWithNextAvailableIP(fixedAddress *IPv6FixedAddress, allocation NextAvailableIP) error {
+	return c.CreateIPv6FixedAddressWithNextAvailableIPWithContext(context.Background(), fixedAddress, allocation)
+}
+
+// CreateIPv6FixedAddressWithNextAvailableIPWithContext creates ipv6 fixed address with the next available
+// address of a network or range using the supplied context
+func (c *Client) CreateIPv6FixedAddressWithNextAvailableIPWithContext(ctx context.Context, fixedAddress *IPv6FixedAddress, allocation NextAvailableIP) error {
+	fixedAddress.NetworkView = c.networkView(fixedAddress.NetworkView)
+	return c.createWithNextAvailableIP(ctx, ipv6FixedAddressBasePath, ipv6FixedAddressReturnFields, fixedAddress, "ipv6addr", allocation)
+}
+
+// CreateHostRecordWithNextAvailableIP creates host record with an additional address
+// allocated from a network or range.  The allocated address is the last entry of
+// IPv4Addrs or IPv6Addrs
+func (c *Client) CreateHostRecordWithNextAvailableIP(hostRecord *HostRecord, allocation NextAvailableIP) error {
+	return c.CreateHostRecordWithNextAvailableIPWithContext(context.Background(), hostRecord, allocation)
+}
+
+// CreateHostRecordWithNextAvailableIPWithContext creates host record with an additional address
+// allocated from a network or range using the supplied context
+func (c *Client) CreateHostRecordWithNextAvailableIPWithContext(ctx context.Context, hostRecord *HostRecord, allocation NextAvailableIP) error {
+	hostRecord.NetworkView = c.networkView(hostRecord.NetworkView)
+	value, ipv6, err := c.nextAvailableIPValue(ctx, allocation)
+	if err != nil {
+		return err
+	}
+	function, isFunction := value.(objectFunction)
+	if ipv6 {
+		address := IPv6Addr{}
+		if isFunction {
+			address.ObjectFunction = function.Function
+			address.Object = function.Object
+			address.ResultField = function.ResultField
+			address.ObjectParameters = function.ObjectParameters
+			address.Parameters = function.Parameters
+		} else {
+			address.IPAddress = value.(string)
+		}
+		hostRecord.IPv6Addrs = append(hostRecord.IPv6Addrs, address)
+	} else {
+		address := IPv4Addr{}
+		if isFunction {
+			address.ObjectFunction = function.Function
+			address.Object = function.Object
+			address.ResultField = function.ResultField
+			address.ObjectParameters = function.ObjectParameters
+			address.Parameters = function.Parameters
+		} else {
+			address.IPAddress = value.(string)
+		}
+		hostRecord.IPv4Addrs = append(hostRecord.IPv4Addrs, address)
+	}
+	if err := c.CreateHostRecordWithContext(ctx, hostRecord); err != nil {
+		return err
+	}
+
+	// Decoding reuses the request addresses so the object function fields
+	// are cleared from the allocated addresses
+	for i := range hostRecord.IPv4Addrs {
+		hostRecord.IPv4Addrs[i].ObjectFunction = ""
+		hostRecord.IPv4Addrs[i].Object = ""
+		hostRecord.IPv4Addrs[i].ResultField = ""
+		hostRecord.IPv4Addrs[i].ObjectParameters = nil
+		hostRecord.IPv4Addrs[i].Parameters = nil
+	}
+	for i := range hostRecord.IPv6Addrs {
+		hostRecord.IPv6Addrs[i].ObjectFunction = ""
+		hostRecord.IPv6Addrs[i].Object = ""
+		hostRecord.IPv6Addrs[i].ResultField = ""
+		hostRecord.IPv6Addrs[i].ObjectParameters = nil
+		hostRecord.IPv6Addrs[i].Parameters = nil
+	}
+	return nil
+}
+
+// createWithNextAvailableIP creates an object replacing its address field with
+// the next available ip of allocation and decodes the result into object
+func (c *Client) createWithNextAvailableIP(ctx context.Context, basePath string, returnFields string, object interface{}, addressField string, allocation NextAvailableIP) error {
+	value, _, err := c.nextAvailableIPValue(ctx, allocation)
+	if err != nil {
+		return err
+	}
+	encoded, err := json.Marshal(object)
+	if err != nil {
+		return err
+	}
+	body := map[string]interface{}{}
+	if err := json.Unmarshal(encoded, &body); err != nil {
+		return err
+	}
+	body[addressField] = value
+
+	queryParams := map[string]string{
+		"_return_fields": returnFields,
+	}
+	queryParamString := c.BuildQuery(queryParams)
+	request, err := c.CreateJSONRequestWithContext(ctx, http.MethodPost, fmt.Sprintf("%s?%s", basePath, queryParamString), body)
+	if err != nil {
+		return err
+	}
+
+	response := c.Call(request, object)
+	if response != nil {
+		return response
+	}
+	return nil
+}
+
+// nextAvailableIPValue returns the address field value allocating from
+// allocation and whether the allocation is for ipv6 addresses
+func (c *Client) nextAvailableIPValue(ctx context.Context, allocation NextAvailableIP) (interface{}, bool, error) {
+	objectType := allocation.Object
+	parameters := map[string]interface{}{}
+	for k, v := range allocation.ObjectParameters {
+		parameters[k] = v
+	}
+
+	if allocation.Ref != "" {
+		objectType = strings.SplitN(allocation.Ref, "/", 2)[0]
+		if len(allocation.Exclude) == 0 {
+			return nextAvailableIPPrefix + allocation.Ref, strings.HasPrefix(objectType, "ipv6"), nil
+		}
+		// Object functions search for the allocating object so the
+		// referenced object is looked up for its identifying fields
+		var found struct {
+			Network      string `json:"network,omitempty"`
+			NetworkView  string `json:"network_view,omitempty"`
+			StartAddress string `json:"start_addr,omitempty"`
+			EndAddress   string `json:"end_addr,omitempty"`
+		}
+		queryParams := map[string]string{
+			"_return_fields": "network,network_view",
+		}
+		if strings.HasSuffix(objectType, "range") {
+			queryParams["_return_fields"] = "start_addr,end_addr,network_view"
+		}
+		request, err := c.CreateJSONRequestWithContext(ctx, http.MethodGet, fmt.Sprintf("%s?%s", allocation.Ref, c.BuildQuery(queryParams)), nil)
+		if err != nil {
+			return nil, false, err
+		}
+		response := c.Call(request, &found)
+		if response != nil {
+			return nil, false, response
+		}
+		parameters["network_view"] = found.NetworkView
+		if found.StartAddress != "" {
+			parameters["start_addr"] = found.StartAddress
+			parameters["end_addr"] = found.EndAddress
+		} else {
+			parameters["network"] = found.Network
+		}
+	}
+
+	switch objectType {
+	case networkBasePath, ipv6NetworkBasePath, rangeBasePath, ipv6RangeBasePath:
+	default:
+		return nil, false, fmt.Errorf("%w: next available ip requires a network or range, got %q", ErrValidation, objectType)
+	}
+	networkView, _ := parameters["network_view"].(string)
+	if networkView = c.networkView(networkView); networkView == "" {
+		networkView = "default"
+	}
+	parameters["network_view"] = networkView
+	function := objectFunction{
+		Function:         nextAvailableIPFunction,
+		ResultField:      "ips",
+		Object:           objectType,
+		ObjectParameters: parameters,
+	}
+	if len(allocation.Exclude) > 0 {
+		function.Parameters = map[string]interface{}{
+			"exclude": allocation.Exclude,
+		}
+	}
+	return function, strings.HasPrefix(objectType, "ipv6"), nil
+}
+
+// GetNextAvailableIPs gets up to count next available ipv4 addresses of the network or
+// range referenced by ref without allocating them, skipping excluded addresses
+func (c *Client) GetNextAvailableIPs(ref string, count int, exclude []string) ([]string, error) {
+	return c.GetNextAvailableIPsWithContext(context.Background(), ref, count, exclude)
+}
+
+// GetNextAvailableIPsWithContext gets up to count next available ipv4 addresses of the network or
+// range referenced by ref without allocating them using the supplied context
+func (c *Client) GetNextAvailableIPsWithContext(ctx context.Context, ref string, count int, exclude []string) ([]string, error) {
+	return c.nextAvailableIPs(ctx, []string{networkBasePath, rangeBasePath}, ref, count, exclude)
+}
+
+// GetNextAvailableIPv6s gets up to count next available ipv6 addresses of the network or
+// range referenced by ref without allocating them, skipping excluded addresses
+func (c *Client) GetNextAvailableIPv6s(ref string, count int, exclude []string) ([]string, error) {
+	return c.GetNextAvailableIPv6sWithContext(context.Background(), ref, count, exclude)
+}
+
+// GetNextAvailableIPv6sWithContext gets up to count next available ipv6 addresses of the network or
+// range referenced by ref without allocating them using the supplied context
+func (c *Client) GetNextAvailableIPv6sWithContext(ctx context.Context, ref string, count int, exclude []string) ([]string, error) {
+	return c.nextAvailableIPs(ctx, []string{ipv6NetworkBasePath, ipv6RangeBasePath}, ref, count, exclude)
+}
+
+// nextAvailableIPs calls the next_available_ip function of a network or range
+// whose object type is one of objectTypes
+func (c *Client) nextAvailableIPs(ctx context.Context, objectTypes []string, ref string, count int, exclude []string) ([]string, error) {
+	var ret struct {
+		IPs []string `json:"ips"`
+	}
+	objectType := strings.SplitN(ref, "/", 2)[0]
+	supported := false
+	for _, t := range objectTypes {
+		supported = supported || t == objectType
+	}
+	if !supported {
+		return nil, fmt.Errorf("%w: next available ip requires a %s reference, got %q", ErrValidation, strings.Join(objectTypes, " or "), ref)
+	}
+	if count <= 0 {
+		return nil, fmt.Errorf("%w: count must be positive, got %d", ErrValidation, count)
+	}
+
+	body := map[string]interface{}{
+		"num": count,
+	}
+	if len(exclude) > 0 {
+		body["exclude"] = exclude
+	}
+	queryParams := map[string]string{
+		"_function": nextAvailableIPFunction,
+	}
+	queryParamString := c.BuildQuery(queryParams)
+	request, err := c.CreateJSONRequestWithContext(ctx, http.MethodPost, fmt.Sprintf("%s?%s", ref, queryParamString), body)
+	if err != nil {
+		return nil, err
+	}
+
+	response := c.Call(request, &ret)
+	if response != nil {
+		return nil, response
+	}
+	return ret.IPs, nil
+}
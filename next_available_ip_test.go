@@ -0,0 +1,138 @@
+package infoblox
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestCreateWithNextAvailableIP(t *testing.T) {
+	client, server := newPaginationTestClient(t, 0)
+	_, err := server.Add("network", map[string]interface{}{
+		"network": "10.2.0.0/24",
+		"extattrs": map[string]interface{}{
+			"Site": map[string]interface{}{"value": "austin"},
+		},
+	})
+	if err != nil {
+		t.Fatalf("Error seeding network: %s", err)
+	}
+	rangeRef, err := server.Add("range", map[string]interface{}{
+		"start_addr": "10.1.3.100",
+		"end_addr":   "10.1.3.110",
+	})
+	if err != nil {
+		t.Fatalf("Error seeding range: %s", err)
+	}
+
+	record := ARecord{Hostname: "www.example.com"}
+	err = client.CreateARecordWithNextAvailableIP(&record, NextAvailableIPInNetwork("10.1.1.0/24", "").WithExclude("10.1.1.1", "10.1.1.2"))
+	if err != nil {
+		t.Fatalf("Error creating A record: %s", err)
+	}
+	if record.IPAddress != "10.1.1.3" || record.Ref == "" {
+		t.Errorf("Expected A record with 10.1.1.3, got %s", record.IPAddress)
+	}
+
+	fixedAddress := FixedAddress{Mac: "00:50:56:00:00:01"}
+	err = client.CreateFixedAddressWithNextAvailableIP(&fixedAddress, NextAvailableIPInNetworkByEA(map[string]string{"Site": "austin"}, ""))
+	if err != nil {
+		t.Fatalf("Error creating fixed address: %s", err)
+	}
+	if fixedAddress.IPAddress != "10.2.0.1" {
+		t.Errorf("Expected fixed address 10.2.0.1, got %s", fixedAddress.IPAddress)
+	}
+
+	host := HostRecord{Hostname: "app.example.com", EnableDNS: newBool(false)}
+	err = client.CreateHostRecordWithNextAvailableIP(&host, NextAvailableIPInRange(rangeRef))
+	if err != nil {
+		t.Fatalf("Error creating host record: %s", err)
+	}
+	if len(host.IPv4Addrs) != 1 || host.IPv4Addrs[0].IPAddress != "10.1.3.100" {
+		t.Errorf("Expected host address 10.1.3.100, got %v", host.IPv4Addrs)
+	}
+
+	host = HostRecord{Hostname: "db.example.com", EnableDNS: newBool(false)}
+	err = client.CreateHostRecordWithNextAvailableIP(&host, NextAvailableIPInRange(rangeRef).WithExclude("10.1.3.101"))
+	if err != nil {
+		t.Fatalf("Error creating host record: %s", err)
+	}
+	if len(host.IPv4Addrs) != 1 || host.IPv4Addrs[0].IPAddress != "10.1.3.102" {
+		t.Errorf("Expected host address 10.1.3.102, got %v", host.IPv4Addrs)
+	}
+	if host.IPv4Addrs[0].ObjectFunction != "" {
+		t.Errorf("Expected object function to be cleared after creation")
+	}
+
+	err = client.CreateARecordWithNextAvailableIP(&ARecord{Hostname: "bad.example.com"}, NextAvailableIP{Object: "record:a"})
+	if !IsValidationError(err) {
+		t.Errorf("Expected validation error, got %v", err)
+	}
+}
+
+func TestGetNextAvailableIPs(t *testing.T) {
+	client, server := newPaginationTestClient(t, 0)
+	networks, err := client.GetNetworkByQuery(map[string]string{"network": "10.1.2.0/24"})
+	if err != nil || len(networks) != 1 {
+		t.Fatalf("Error retrieving network: %v", err)
+	}
+	ipv6NetworkRef, err := server.Add("ipv6network", map[string]interface{}{"network": "fd00:10::/64"})
+	if err != nil {
+		t.Fatalf("Error seeding ipv6 network: %s", err)
+	}
+
+	ips, err := client.GetNextAvailableIPs(networks[0].Ref, 3, []string{"10.1.2.2"})
+	if err != nil {
+		t.Fatalf("Error getting next available ips: %s", err)
+	}
+	if strings.Join(ips, ",") != "10.1.2.1,10.1.2.3,10.1.2.4" {
+		t.Errorf("Expected 10.1.2.1,10.1.2.3,10.1.2.4, got %v", ips)
+	}
+	if len(server.Objects("record:a")) != 0 || len(server.Objects("fixedaddress")) != 0 {
+		t.Errorf("Expected no objects to be created")
+	}
+
+	ips, err = client.GetNextAvailableIPv6s(ipv6NetworkRef, 2, nil)
+	if err != nil {
+		t.Fatalf("Error getting next available ipv6 addresses: %s", err)
+	}
+	if len(ips) != 2 || !strings.HasPrefix(ips[0], "fd00:10::") {
+		t.Errorf("Expected 2 addresses in fd00:10::/64, got %v", ips)
+	}
+
+	_, err = client.GetNextAvailableIPs(ipv6NetworkRef, 1, nil)
+	if !IsValidationError(err) {
+		t.Errorf("Expected validation error for ipv6 reference, got %v", err)
+	}
+}
+
+func TestGetSequentialAddressRangeSkipsRanges(t *testing.T) {
+	client, server := newPaginationTestClient(t, 4)
+	if _, err := server.Add("range", map[string]interface{}{"start_addr": "10.1.4.10", "end_addr": "10.1.4.20"}); err != nil {
+		t.Fatalf("Error seeding range: %s", err)
+	}
+	if _, err := server.Add("fixedaddress", map[string]interface{}{"ipv4addr": "10.1.4.25", "mac": "00:50:56:00:00:01"}); err != nil {
+		t.Fatalf("Error seeding fixed address: %s", err)
+	}
+
+	addresses, err := client.GetSequentialAddressRange(AddressQuery{CIDR: "10.1.4.0/24", Count: 10, StartAddress: "10.1.4.5"})
+	if err != nil {
+		t.Fatalf("Error getting sequential address range: %s", err)
+	}
+	if len(*addresses) != 10 || (*addresses)[0].IPAddress != "10.1.4.26" || (*addresses)[9].IPAddress != "10.1.4.35" {
+		t.Errorf("Expected 10.1.4.26 to 10.1.4.35, got %v", *addresses)
+	}
+	for _, request := range server.Requests() {
+		if strings.Contains(request, "next_available_ip") {
+			t.Errorf("Expected unused addresses to be paged instead of excluded, got %s", request)
+		}
+	}
+
+	_, err = client.GetSequentialAddressRange(AddressQuery{CIDR: "10.1.4.0/24", Count: 10, EndAddress: "10.1.4.30"})
+	if err == nil {
+		t.Errorf("Expected no sequential block before 10.1.4.30")
+	}
+	_, err = client.GetSequentialAddressRange(AddressQuery{CIDR: "10.1.4.0/24", Count: 250})
+	if err == nil {
+		t.Errorf("Expected no sequential block of 250 addresses")
+	}
+}
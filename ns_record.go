@@ -0,0 +1,127 @@
+package infoblox
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+const (
+	nsRecordBasePath     = "record:ns"
+	nsRecordReturnFields = "name,nameserver,addresses,view,zone,dns_name,creator,ms_delegation_name"
+)
+
+// GetNSRecordByRef gets NS record by reference
+func (c *Client) GetNSRecordByRef(ref string, queryParams map[string]string) (NSRecord, error) {
+	return c.GetNSRecordByRefWithContext(context.Background(), ref, queryParams)
+}
+
+// GetNSRecordByRefWithContext gets NS record by reference using the supplied context
+func (c *Client) GetNSRecordByRefWithContext(ctx context.Context, ref string, queryParams map[string]string) (NSRecord, error) {
+	var ret NSRecord
+	queryParams = returnFields(queryParams, nsRecordReturnFields)
+
+	queryParamString := c.BuildQuery(queryParams)
+
+	request, err := c.CreateJSONRequestWithContext(ctx, http.MethodGet, fmt.Sprintf("%s?%s", ref, queryParamString), nil)
+	if err != nil {
+		return ret, err
+	}
+
+	response := c.Call(request, &ret)
+	if response != nil {
+		return ret, response
+	}
+
+	return ret, nil
+}
+
+// GetNSRecordByQuery gets NS records by query parameters
+func (c *Client) GetNSRecordByQuery(queryParams map[string]string) ([]NSRecord, error) {
+	return c.GetNSRecordByQueryWithContext(context.Background(), queryParams)
+}
+
+// GetNSRecordByQueryWithContext gets NS records by query parameters using the supplied context
+func (c *Client) GetNSRecordByQueryWithContext(ctx context.Context, queryParams map[string]string) ([]NSRecord, error) {
+	return c.ListNSRecordsWithContext(ctx, queryParams)
+}
+
+// ListNSRecords lists all NS records matching query parameters following every result page
+func (c *Client) ListNSRecords(queryParams map[string]string) ([]NSRecord, error) {
+	return c.ListNSRecordsWithContext(context.Background(), queryParams)
+}
+
+// ListNSRecordsWithContext lists all NS records matching query parameters following every result page using the supplied context
+func (c *Client) ListNSRecordsWithContext(ctx context.Context, queryParams map[string]string) ([]NSRecord, error) {
+	return listWithReturnFields[NSRecord](ctx, c, nsRecordBasePath, nsRecordReturnFields, queryParams)
+}
+
+// CreateNSRecord creates NS record
+func (c *Client) CreateNSRecord(record *NSRecord) error {
+	return c.CreateNSRecordWithContext(context.Background(), record)
+}
+
+// CreateNSRecordWithContext creates NS record using the supplied context
+func (c *Client) CreateNSRecordWithContext(ctx context.Context, record *NSRecord) error {
+	record.View = c.dnsView(record.View)
+	queryParams := map[string]string{
+		"_return_fields": nsRecordReturnFields,
+	}
+	queryParamString := c.BuildQuery(queryParams)
+	request, err := c.CreateJSONRequestWithContext(ctx, http.MethodPost, fmt.Sprintf("%s?%s", nsRecordBasePath, queryParamString), record)
+	if err != nil {
+		return err
+	}
+
+	response := c.Call(request, &record)
+	if response != nil {
+		return response
+	}
+	return nil
+}
+
+// UpdateNSRecord updates NS record
+func (c *Client) UpdateNSRecord(ref string, record NSRecord) (NSRecord, error) {
+	return c.UpdateNSRecordWithContext(context.Background(), ref, record)
+}
+
+// UpdateNSRecordWithContext updates NS record using the supplied context
+func (c *Client) UpdateNSRecordWithContext(ctx context.Context, ref string, record NSRecord) (NSRecord, error) {
+	var ret NSRecord
+	queryParams := map[string]string{
+		"_return_fields": nsRecordReturnFields,
+	}
+	queryParamString := c.BuildQuery(queryParams)
+	request, err := c.CreateJSONRequestWithContext(ctx, http.MethodPut, fmt.Sprintf("%s?%s", ref, queryParamString), record)
+	if err != nil {
+		return ret, err
+	}
+
+	response := c.Call(request, &ret)
+	if response != nil {
+		return ret, response
+	}
+	return ret, nil
+}
+
+// DeleteNSRecord deletes NS record
+func (c *Client) DeleteNSRecord(ref string) error {
+	return c.DeleteNSRecordWithContext(context.Background(), ref)
+}
+
+// DeleteNSRecordWithContext deletes NS record using the supplied context
+func (c *Client) DeleteNSRecordWithContext(ctx context.Context, ref string) error {
+	request, err := c.CreateJSONRequestWithContext(ctx, http.MethodDelete, fmt.Sprintf("%s", ref), nil)
+	if err != nil {
+		return err
+	}
+
+	response := c.Call(request, nil)
+	if response != nil {
+		if IsNotFound(response) {
+			return nil
+		}
+		return response
+	}
+	return nil
+}
@@ -0,0 +1,151 @@
+package infoblox
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+)
+
+const (
+	defaultPageSize = 1000
+)
+
+// queryPage generic page of WAPI query results
+type queryPage[T any] struct {
+	NextPageID string `json:"next_page_id,omitempty"`
+	Results    []T    `json:"result,omitempty"`
+}
+
+// Iterator walks every object returned by a WAPI query following next_page_id
+type Iterator[T any] struct {
+	client      *Client
+	ctx         context.Context
+	objectType  string
+	queryParams map[string]string
+	pageID      string
+	page        []T
+	index       int
+	started     bool
+	err         error
+}
+
+// NewIterator creates an iterator over objectType matching queryParams.
+// A pageSize of 0 uses the client page size
+func NewIterator[T any](ctx context.Context, c *Client, objectType string, queryParams map[string]string, pageSize int) *Iterator[T] {
+	params := map[string]string{}
+	for k, v := range queryParams {
+		params[k] = v
+	}
+	if pageSize <= 0 {
+		pageSize = c.pageSize()
+	}
+	params["_paging"] = "1"
+	params["_return_as_object"] = "1"
+	params["_max_results"] = strconv.Itoa(pageSize)
+	delete(params, "_page_id")
+	return &Iterator[T]{
+		client:      c,
+		ctx:         ctx,
+		objectType:  objectType,
+		queryParams: params,
+		index:       -1,
+	}
+}
+
+// Next advances to the next object, fetching pages as needed.  It returns
+// false once all objects have been read or an error occurs
+func (it *Iterator[T]) Next() bool {
+	if it.err != nil {
+		return false
+	}
+	it.index++
+	for it.index >= len(it.page) {
+		if it.started && it.pageID == "" {
+			return false
+		}
+		if err := it.fetch(); err != nil {
+			it.err = err
+			return false
+		}
+	}
+	return true
+}
+
+// Value returns the current object
+func (it *Iterator[T]) Value() T {
+	return it.page[it.index]
+}
+
+// Err returns the error that stopped iteration, if any
+func (it *Iterator[T]) Err() error {
+	return it.err
+}
+
+func (it *Iterator[T]) fetch() error {
+	var ret queryPage[T]
+	if it.pageID != "" {
+		it.queryParams["_page_id"] = it.pageID
+	}
+	queryParamString := it.client.BuildQuery(it.queryParams)
+	request, err := it.client.CreateJSONRequestWithContext(it.ctx, http.MethodGet, fmt.Sprintf("%s?%s", it.objectType, queryParamString), nil)
+	if err != nil {
+		return err
+	}
+
+	response := it.client.Call(request, &ret)
+	if response != nil {
+		return response
+	}
+	it.started = true
+	it.pageID = ret.NextPageID
+	it.page = ret.Results
+	it.index = 0
+	return nil
+}
+
+// ForEach calls fn for every object of objectType matching queryParams,
+// stopping at the first error returned by fn
+func ForEach[T any](ctx context.Context, c *Client, objectType string, queryParams map[string]string, pageSize int, fn func(T) error) error {
+	it := NewIterator[T](ctx, c, objectType, queryParams, pageSize)
+	for it.Next() {
+		if err := fn(it.Value()); err != nil {
+			return err
+		}
+	}
+	return it.Err()
+}
+
+// ListAll returns every object of objectType matching queryParams sorted
+// by the fields of Query.OrderBy
+func ListAll[T any](ctx context.Context, c *Client, objectType string, queryParams map[string]string, pageSize int) ([]T, error) {
+	ret := []T{}
+	err := ForEach(ctx, c, objectType, queryParams, pageSize, func(object T) error {
+		ret = append(ret, object)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if orderBy := queryParams[orderByParam]; orderBy != "" {
+		if err := sortObjects(ret, orderBy); err != nil {
+			return nil, err
+		}
+	}
+	return ret, nil
+}
+
+// listWithReturnFields lists objects returning defaultFields unless the caller
+// supplies its own return fields.  Searches without a view are limited to the
+// configured default view
+func listWithReturnFields[T any](ctx context.Context, c *Client, objectType string, defaultFields string, queryParams map[string]string) ([]T, error) {
+	queryParams = c.withDefaultView(objectType, returnFields(queryParams, defaultFields))
+	return ListAll[T](ctx, c, objectType, queryParams, 0)
+}
+
+func (c *Client) pageSize() int {
+	if c.config.PageSize > 0 {
+		return c.config.PageSize
+	}
+	return defaultPageSize
+}
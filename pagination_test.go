@@ -0,0 +1,98 @@
+package infoblox
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/techBeck03/infoblox-go-sdk/infobloxtest"
+)
+
+func newPaginationTestClient(t *testing.T, pageSize int) (*Client, *infobloxtest.Server) {
+	t.Helper()
+	server := infobloxtest.NewServer()
+	t.Cleanup(server.Close)
+	for i := 0; i < 5; i++ {
+		_, err := server.Add("network", map[string]interface{}{
+			"network": fmt.Sprintf("10.1.%d.0/24", i),
+			"comment": fmt.Sprintf("Network %d", i%2),
+		})
+		if err != nil {
+			t.Fatalf("Error seeding network: %s", err)
+		}
+	}
+	client := New(Config{
+		Host:                   server.Host(),
+		Port:                   server.Port(),
+		Version:                infobloxtest.Version,
+		DisableTLSVerification: true,
+		PageSize:               pageSize,
+	})
+	return client, server
+}
+
+func TestListNetworksFollowsPages(t *testing.T) {
+	client, server := newPaginationTestClient(t, 2)
+
+	networks, err := client.ListNetworks(map[string]string{})
+	if err != nil {
+		t.Fatalf("Error listing networks: %s", err)
+	}
+	if len(networks) != 5 {
+		t.Errorf("Expected 5 networks, got %d", len(networks))
+	}
+	if len(server.Requests()) != 3 {
+		t.Errorf("Expected 3 page requests, got %v", server.Requests())
+	}
+
+	networks, err = client.ListNetworks(map[string]string{"comment": "Network 1"})
+	if err != nil {
+		t.Fatalf("Error listing networks: %s", err)
+	}
+	if len(networks) != 2 {
+		t.Errorf("Expected 2 networks matching comment, got %d", len(networks))
+	}
+}
+
+func TestGetByQueryReturnsEveryMatch(t *testing.T) {
+	client, _ := newPaginationTestClient(t, 2)
+
+	networks, err := client.GetNetworkByQuery(map[string]string{"comment": "Network 0"})
+	if err != nil {
+		t.Fatalf("Error querying networks: %s", err)
+	}
+	if len(networks) != 3 {
+		t.Errorf("Expected 3 networks matching comment, got %d", len(networks))
+	}
+}
+
+func TestForEachStopsOnError(t *testing.T) {
+	client, _ := newPaginationTestClient(t, 0)
+	stop := errors.New("stop")
+	var seen []string
+	err := ForEach(context.Background(), client, networkBasePath, nil, 1, func(network Network) error {
+		seen = append(seen, network.CIDR)
+		if len(seen) == 3 {
+			return stop
+		}
+		return nil
+	})
+	if err != stop {
+		t.Errorf("Expected callback error to be returned, got %v", err)
+	}
+	if len(seen) != 3 {
+		t.Errorf("Expected iteration to stop after 3 networks, got %v", seen)
+	}
+}
+
+func TestIteratorEmptyResult(t *testing.T) {
+	client, _ := newPaginationTestClient(t, 0)
+	it := NewIterator[Network](context.Background(), client, networkBasePath, map[string]string{"comment": "missing"}, 0)
+	if it.Next() {
+		t.Errorf("Expected no networks, got %v", it.Value())
+	}
+	if it.Err() != nil {
+		t.Errorf("Unexpected error: %s", it.Err())
+	}
+}
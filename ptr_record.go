@@ -1,6 +1,7 @@
 package infoblox
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 )
@@ -12,25 +13,24 @@ const (
 
 // GetPtrRecordByRef gets ptr record by reference
 func (c *Client) GetPtrRecordByRef(ref string, queryParams map[string]string) (PtrRecord, error) {
+	return c.GetPtrRecordByRefWithContext(context.Background(), ref, queryParams)
+}
+
+// GetPtrRecordByRefWithContext gets ptr record by reference using the supplied context
+func (c *Client) GetPtrRecordByRefWithContext(ctx context.Context, ref string, queryParams map[string]string) (PtrRecord, error) {
 	var ret PtrRecord
-	if queryParams == nil {
-		queryParams = map[string]string{
-			"_return_fields": ptrRecordReturnFields,
-		}
-	} else {
-		queryParams["_return_fields"] = ptrRecordReturnFields
-	}
+	queryParams = returnFields(queryParams, ptrRecordReturnFields)
 
 	queryParamString := c.BuildQuery(queryParams)
 
-	request, err := c.CreateJSONRequest(http.MethodGet, fmt.Sprintf("%s?%s", ref, queryParamString), nil)
+	request, err := c.CreateJSONRequestWithContext(ctx, http.MethodGet, fmt.Sprintf("%s?%s", ref, queryParamString), nil)
 	if err != nil {
 		return ret, err
 	}
 
 	response := c.Call(request, &ret)
 	if response != nil {
-		return ret, fmt.Errorf(response.ErrorMessage)
+		return ret, response
 	}
 
 	return ret, nil
@@ -38,76 +38,90 @@ func (c *Client) GetPtrRecordByRef(ref string, queryParams map[string]string) (P
 
 // GetPtrRecordByQuery gets ptr records by query parameters
 func (c *Client) GetPtrRecordByQuery(queryParams map[string]string) ([]PtrRecord, error) {
-	var ret PtrRecordQueryResult
-	queryParams["_return_fields"] = ptrRecordReturnFields
-	queryParams["_return_as_object"] = "1"
-	queryParams["_paging"] = "0"
-	queryParams["_max_results"] = "2"
+	return c.GetPtrRecordByQueryWithContext(context.Background(), queryParams)
+}
 
-	queryParamString := c.BuildQuery(queryParams)
-	request, err := c.CreateJSONRequest(http.MethodGet, fmt.Sprintf("%s?%s", ptrRecordBasePath, queryParamString), nil)
-	if err != nil {
-		return nil, err
-	}
+// GetPtrRecordByQueryWithContext gets ptr records by query parameters using the supplied context
+func (c *Client) GetPtrRecordByQueryWithContext(ctx context.Context, queryParams map[string]string) ([]PtrRecord, error) {
+	return c.ListPtrRecordsWithContext(ctx, queryParams)
+}
 
-	response := c.Call(request, &ret)
-	if response != nil {
-		return nil, fmt.Errorf(response.ErrorMessage)
-	}
+// ListPtrRecords lists all PTR records matching query parameters following every result page
+func (c *Client) ListPtrRecords(queryParams map[string]string) ([]PtrRecord, error) {
+	return c.ListPtrRecordsWithContext(context.Background(), queryParams)
+}
 
-	return ret.Results, nil
+// ListPtrRecordsWithContext lists all PTR records matching query parameters following every result page using the supplied context
+func (c *Client) ListPtrRecordsWithContext(ctx context.Context, queryParams map[string]string) ([]PtrRecord, error) {
+	return listWithReturnFields[PtrRecord](ctx, c, ptrRecordBasePath, ptrRecordReturnFields, queryParams)
 }
 
 // CreatePtrRecord creates ptr record
 func (c *Client) CreatePtrRecord(record *PtrRecord) error {
+	return c.CreatePtrRecordWithContext(context.Background(), record)
+}
+
+// CreatePtrRecordWithContext creates ptr record using the supplied context
+func (c *Client) CreatePtrRecordWithContext(ctx context.Context, record *PtrRecord) error {
+	record.View = c.dnsView(record.View)
 	queryParams := map[string]string{
 		"_return_fields": ptrRecordReturnFields,
 	}
 	queryParamString := c.BuildQuery(queryParams)
-	request, err := c.CreateJSONRequest(http.MethodPost, fmt.Sprintf("%s?%s", ptrRecordBasePath, queryParamString), record)
+	request, err := c.CreateJSONRequestWithContext(ctx, http.MethodPost, fmt.Sprintf("%s?%s", ptrRecordBasePath, queryParamString), record)
 	if err != nil {
 		return err
 	}
 
 	response := c.Call(request, &record)
 	if response != nil {
-		return fmt.Errorf(response.ErrorMessage)
+		return response
 	}
 	return nil
 }
 
 // UpdatePtrRecord creates ptr record
 func (c *Client) UpdatePtrRecord(ref string, network PtrRecord) (PtrRecord, error) {
+	return c.UpdatePtrRecordWithContext(context.Background(), ref, network)
+}
+
+// UpdatePtrRecordWithContext creates ptr record using the supplied context
+func (c *Client) UpdatePtrRecordWithContext(ctx context.Context, ref string, network PtrRecord) (PtrRecord, error) {
 	var ret PtrRecord
 	queryParams := map[string]string{
 		"_return_fields": ptrRecordReturnFields,
 	}
 	queryParamString := c.BuildQuery(queryParams)
-	request, err := c.CreateJSONRequest(http.MethodPut, fmt.Sprintf("%s?%s", ref, queryParamString), network)
+	request, err := c.CreateJSONRequestWithContext(ctx, http.MethodPut, fmt.Sprintf("%s?%s", ref, queryParamString), network)
 	if err != nil {
 		return ret, err
 	}
 
 	response := c.Call(request, &ret)
 	if response != nil {
-		return ret, fmt.Errorf(response.ErrorMessage)
+		return ret, response
 	}
 	return ret, nil
 }
 
 // DeletePtrRecord creates ptr record
 func (c *Client) DeletePtrRecord(ref string) error {
-	request, err := c.CreateJSONRequest(http.MethodDelete, fmt.Sprintf("%s", ref), nil)
+	return c.DeletePtrRecordWithContext(context.Background(), ref)
+}
+
+// DeletePtrRecordWithContext creates ptr record using the supplied context
+func (c *Client) DeletePtrRecordWithContext(ctx context.Context, ref string) error {
+	request, err := c.CreateJSONRequestWithContext(ctx, http.MethodDelete, fmt.Sprintf("%s", ref), nil)
 	if err != nil {
 		return err
 	}
 
 	response := c.Call(request, nil)
 	if response != nil {
-		if response.StatusCode == 404 {
+		if IsNotFound(response) {
 			return nil
 		}
-		return err
+		return response
 	}
 	return nil
 }
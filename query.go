@@ -0,0 +1,218 @@
+package infoblox
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/url"
+	"sort"
+	"strings"
+)
+
+// orderByParam holds the fields results are sorted by.  It is never sent to
+// WAPI which has no server side ordering
+const orderByParam = "_order_by"
+
+// SearchModifier is a WAPI search modifier appended to a field name
+type SearchModifier string
+
+// WAPI search modifiers
+const (
+	SearchNot             SearchModifier = "!"
+	SearchCaseInsensitive SearchModifier = ":"
+	SearchRegex           SearchModifier = "~"
+	SearchLessOrEqual     SearchModifier = "<"
+	SearchGreaterOrEqual  SearchModifier = ">"
+)
+
+// searchModifierOrder is the order modifiers are appended to a field name
+var searchModifierOrder = []SearchModifier{SearchNot, SearchCaseInsensitive, SearchRegex, SearchLessOrEqual, SearchGreaterOrEqual}
+
+// Query builds WAPI search arguments.  It is a map of query parameters so it
+// can be passed to any Get*ByQuery or List* method in place of a hand written map.
+// WAPI returns objects in grid order and cannot sort them, so OrderBy is
+// applied by the SDK once every result page has been fetched
+type Query map[string]string
+
+// NewQuery creates an empty query
+func NewQuery() Query {
+	return Query{}
+}
+
+// Where adds a search argument for field with the supplied modifiers
+func (q Query) Where(field string, value string, modifiers ...SearchModifier) Query {
+	var key strings.Builder
+	key.WriteString(field)
+	for _, modifier := range searchModifierOrder {
+		for _, m := range modifiers {
+			if m == modifier {
+				key.WriteString(string(modifier))
+				break
+			}
+		}
+	}
+	q[key.String()] = value
+	return q
+}
+
+// Equal matches objects where field equals value
+func (q Query) Equal(field string, value string) Query {
+	return q.Where(field, value)
+}
+
+// NotEqual matches objects where field does not equal value
+func (q Query) NotEqual(field string, value string) Query {
+	return q.Where(field, value, SearchNot)
+}
+
+// EqualFold matches objects where field equals value ignoring case
+func (q Query) EqualFold(field string, value string) Query {
+	return q.Where(field, value, SearchCaseInsensitive)
+}
+
+// Regex matches objects where field matches the regular expression pattern
+func (q Query) Regex(field string, pattern string) Query {
+	return q.Where(field, pattern, SearchRegex)
+}
+
+// LessOrEqual matches objects where field is less than or equal to value
+func (q Query) LessOrEqual(field string, value string) Query {
+	return q.Where(field, value, SearchLessOrEqual)
+}
+
+// GreaterOrEqual matches objects where field is greater than or equal to value
+func (q Query) GreaterOrEqual(field string, value string) Query {
+	return q.Where(field, value, SearchGreaterOrEqual)
+}
+
+// ExtensibleAttribute matches objects where extensible attribute name has
+// value using the supplied modifiers
+func (q Query) ExtensibleAttribute(name string, value string, modifiers ...SearchModifier) Query {
+	return q.Where("*"+name, value, modifiers...)
+}
+
+// ReturnFields requests fields in addition to the basic fields of the object
+func (q Query) ReturnFields(fields ...string) Query {
+	if existing := q["_return_fields+"]; existing != "" {
+		fields = append(strings.Split(existing, ","), fields...)
+	}
+	q["_return_fields+"] = strings.Join(fields, ",")
+	return q
+}
+
+// Fields replaces the default return fields of the SDK with fields
+func (q Query) Fields(fields ...string) Query {
+	q["_return_fields"] = strings.Join(fields, ",")
+	return q
+}
+
+// OrderBy sorts results by fields, a field prefixed with - sorts in
+// descending order.  Sorting is done client side by ListAll and the List* and
+// Get*ByQuery methods, Iterator and ForEach return objects in grid order.
+// Fields must be among the returned fields of the object
+func (q Query) OrderBy(fields ...string) Query {
+	if existing := q[orderByParam]; existing != "" {
+		fields = append(strings.Split(existing, ","), fields...)
+	}
+	q[orderByParam] = strings.Join(fields, ",")
+	return q
+}
+
+// Encode serializes the query as WAPI query parameters sorted by key
+func (q Query) Encode() string {
+	values := url.Values{}
+	for k, v := range q {
+		if k != orderByParam {
+			values.Add(k, v)
+		}
+	}
+	return values.Encode()
+}
+
+// sortObjects sorts objects by the comma separated fields of orderBy
+func sortObjects[T any](objects []T, orderBy string) error {
+	type sortable struct {
+		object T
+		fields map[string]interface{}
+	}
+	items := make([]sortable, len(objects))
+	for i, object := range objects {
+		encoded, err := json.Marshal(object)
+		if err != nil {
+			return err
+		}
+		items[i].object = object
+		if err := json.Unmarshal(encoded, &items[i].fields); err != nil {
+			return err
+		}
+	}
+	keys := strings.Split(orderBy, ",")
+	sort.SliceStable(items, func(i, j int) bool {
+		for _, key := range keys {
+			field := strings.TrimPrefix(key, "-")
+			result := compareValues(items[i].fields[field], items[j].fields[field])
+			if result == 0 {
+				continue
+			}
+			if strings.HasPrefix(key, "-") {
+				return result > 0
+			}
+			return result < 0
+		}
+		return false
+	})
+	for i, item := range items {
+		objects[i] = item.object
+	}
+	return nil
+}
+
+// compareValues compares decoded field values.  Numbers compare numerically,
+// addresses and networks by address and prefix length and other values as
+// text.  Missing values sort last
+func compareValues(a interface{}, b interface{}) int {
+	switch {
+	case a == nil && b == nil:
+		return 0
+	case a == nil:
+		return 1
+	case b == nil:
+		return -1
+	}
+	if aNumber, ok := a.(float64); ok {
+		if bNumber, ok := b.(float64); ok {
+			switch {
+			case aNumber < bNumber:
+				return -1
+			case aNumber > bNumber:
+				return 1
+			}
+			return 0
+		}
+	}
+	aText, bText := fmt.Sprint(a), fmt.Sprint(b)
+	aAddress, aPrefix, aOK := parseAddress(aText)
+	bAddress, bPrefix, bOK := parseAddress(bText)
+	if aOK && bOK {
+		if result := bytes.Compare(aAddress, bAddress); result != 0 {
+			return result
+		}
+		return aPrefix - bPrefix
+	}
+	return strings.Compare(aText, bText)
+}
+
+// parseAddress parses an IP address or CIDR returning its 16 byte address
+// and prefix length
+func parseAddress(value string) (net.IP, int, bool) {
+	if ip := net.ParseIP(value); ip != nil {
+		return ip.To16(), 0, true
+	}
+	ip, network, err := net.ParseCIDR(value)
+	if err != nil {
+		return nil, 0, false
+	}
+	prefix, _ := network.Mask.Size()
+	return ip.To16(), prefix, true
+}
@@ -0,0 +1,148 @@
+package infoblox
+
+import (
+	"net/url"
+	"strings"
+	"testing"
+)
+
+func TestQueryEncode(t *testing.T) {
+	tests := []struct {
+		name     string
+		query    Query
+		expected string
+	}{
+		{"equal", NewQuery().Equal("name", "www.example.com"), "name=www.example.com"},
+		{"not equal", NewQuery().NotEqual("view", "default"), "view%21=default"},
+		{"case insensitive regex", NewQuery().Where("name", "^www", SearchRegex, SearchCaseInsensitive), "name%3A~=%5Ewww"},
+		{"negated regex", NewQuery().Where("comment", "test", SearchRegex, SearchNot), "comment%21~=test"},
+		{"address range", NewQuery().GreaterOrEqual("ip_address", "10.0.0.10").LessOrEqual("ip_address", "10.0.0.20"),
+			"ip_address%3C=10.0.0.20&ip_address%3E=10.0.0.10"},
+		{"extensible attribute", NewQuery().ExtensibleAttribute("Site", "austin", SearchCaseInsensitive), "%2ASite%3A=austin"},
+		{"return fields", NewQuery().ReturnFields("ttl").ReturnFields("use_ttl", "extattrs"), "_return_fields%2B=ttl%2Cuse_ttl%2Cextattrs"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if encoded := test.query.Encode(); encoded != test.expected {
+				t.Errorf("Expected %s, got %s", test.expected, encoded)
+			}
+		})
+	}
+}
+
+func TestQuerySearchModifiers(t *testing.T) {
+	client, _ := newPaginationTestClient(t, 0)
+
+	networks, err := client.ListNetworks(NewQuery().Regex("network", `^10\.1\.[0-2]\.`).NotEqual("comment", "Network 1"))
+	if err != nil {
+		t.Fatalf("Error listing networks: %s", err)
+	}
+	if len(networks) != 2 {
+		t.Errorf("Expected 2 networks, got %d", len(networks))
+	}
+
+	networks, err = client.GetNetworkByQuery(NewQuery().EqualFold("comment", "NETWORK 1").Regex("network", `^10\.1\.1\.`))
+	if err != nil {
+		t.Fatalf("Error querying networks: %s", err)
+	}
+	if len(networks) != 1 || networks[0].CIDR != "10.1.1.0/24" {
+		t.Errorf("Expected network 10.1.1.0/24, got %v", networks)
+	}
+}
+
+func TestQueryReturnFields(t *testing.T) {
+	client, _ := newPaginationTestClient(t, 0)
+
+	networks, err := client.ListNetworks(NewQuery().Equal("network", "10.1.1.0/24").Fields("network"))
+	if err != nil {
+		t.Fatalf("Error listing networks: %s", err)
+	}
+	if len(networks) != 1 || networks[0].CIDR != "10.1.1.0/24" || networks[0].Comment != "" {
+		t.Errorf("Expected network without comment, got %v", networks)
+	}
+
+	record := ARecord{Hostname: "www.example.com", IPAddress: "10.1.1.10", TTL: newInt(300), UseTTL: newBool(true)}
+	if err := client.CreateARecord(&record); err != nil {
+		t.Fatalf("Error creating A record: %s", err)
+	}
+	record, err = client.GetARecordByRef(record.Ref, nil)
+	if err != nil {
+		t.Fatalf("Error getting A record: %s", err)
+	}
+	if record.TTL != nil {
+		t.Errorf("Expected ttl to be excluded from the default return fields")
+	}
+	record, err = client.GetARecordByRef(record.Ref, NewQuery().ReturnFields("ttl", "use_ttl"))
+	if err != nil {
+		t.Fatalf("Error getting A record: %s", err)
+	}
+	if record.TTL == nil || *record.TTL != 300 || record.UseTTL == nil || !*record.UseTTL {
+		t.Errorf("Expected ttl 300, got %v", record.TTL)
+	}
+	if record.IPAddress != "10.1.1.10" {
+		t.Errorf("Expected default return fields with ttl, got %v", record)
+	}
+}
+
+func TestQueryReuse(t *testing.T) {
+	client, server := newPaginationTestClient(t, 0)
+	query := NewQuery().Equal("name", "www.example.com").ReturnFields("ttl")
+
+	if _, err := client.GetARecordByQuery(query); err != nil {
+		t.Fatalf("Error getting A records: %s", err)
+	}
+	if len(query) != 2 || query["_return_fields+"] != "ttl" || query["_return_fields"] != "" || query["_max_results"] != "" {
+		t.Errorf("Expected query to be left untouched, got %v", query)
+	}
+	if _, err := client.GetHostRecordByQuery(query); err != nil {
+		t.Fatalf("Error getting host records: %s", err)
+	}
+	requests := server.Requests()
+	last, err := url.Parse(strings.TrimPrefix(requests[len(requests)-1], "GET "))
+	if err != nil {
+		t.Fatalf("Error parsing request: %s", err)
+	}
+	if fields := last.Query().Get("_return_fields"); fields != hostRecordReturnFields+",ttl" {
+		t.Errorf("Expected host record fields with ttl, got %s", fields)
+	}
+}
+
+func TestQueryOrderBy(t *testing.T) {
+	client, server := newPaginationTestClient(t, 2)
+	if _, err := server.Add("network", map[string]interface{}{"network": "10.1.10.0/24", "comment": "Network 1"}); err != nil {
+		t.Fatalf("Error seeding network: %s", err)
+	}
+
+	networks, err := client.ListNetworks(NewQuery().OrderBy("-network"))
+	if err != nil {
+		t.Fatalf("Error listing networks: %s", err)
+	}
+	var cidrs []string
+	for _, network := range networks {
+		cidrs = append(cidrs, network.CIDR)
+	}
+	if strings.Join(cidrs, ",") != "10.1.10.0/24,10.1.4.0/24,10.1.3.0/24,10.1.2.0/24,10.1.1.0/24,10.1.0.0/24" {
+		t.Errorf("Expected networks in descending address order, got %v", cidrs)
+	}
+
+	networks, err = client.GetNetworkByQuery(NewQuery().OrderBy("comment").OrderBy("-network"))
+	if err != nil {
+		t.Fatalf("Error querying networks: %s", err)
+	}
+	cidrs = nil
+	for _, network := range networks {
+		cidrs = append(cidrs, network.CIDR)
+	}
+	if strings.Join(cidrs, ",") != "10.1.4.0/24,10.1.2.0/24,10.1.0.0/24,10.1.10.0/24,10.1.3.0/24,10.1.1.0/24" {
+		t.Errorf("Expected networks ordered by comment then descending address, got %v", cidrs)
+	}
+
+	for _, request := range server.Requests() {
+		if strings.Contains(request, orderByParam) {
+			t.Errorf("Expected ordering not to be sent to WAPI, got %s", request)
+		}
+	}
+	if encoded := NewQuery().Equal("name", "www").OrderBy("name").Encode(); encoded != "name=www" {
+		t.Errorf("Expected ordering not to be encoded, got %s", encoded)
+	}
+}
@@ -1,6 +1,8 @@
 package infoblox
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"log"
 	"math/rand"
@@ -18,65 +20,70 @@ const (
 
 // GetRangeByRef gets range by reference
 func (c *Client) GetRangeByRef(ref string, queryParams map[string]string) (Range, error) {
+	return c.GetRangeByRefWithContext(context.Background(), ref, queryParams)
+}
+
+// GetRangeByRefWithContext gets range by reference using the supplied context
+func (c *Client) GetRangeByRefWithContext(ctx context.Context, ref string, queryParams map[string]string) (Range, error) {
 	var ret Range
 
-	if queryParams == nil {
-		queryParams = map[string]string{
-			"_return_fields": rangeReturnFields,
-		}
-	} else {
-		queryParams["_return_fields"] = rangeReturnFields
-	}
+	queryParams = returnFields(queryParams, rangeReturnFields)
 
 	queryParamString := c.BuildQuery(queryParams)
-	request, err := c.CreateJSONRequest(http.MethodGet, fmt.Sprintf("%s?%s", ref, queryParamString), nil)
+	request, err := c.CreateJSONRequestWithContext(ctx, http.MethodGet, fmt.Sprintf("%s?%s", ref, queryParamString), nil)
 	if err != nil {
 		return ret, err
 	}
 
 	response := c.Call(request, &ret)
 	if response != nil {
-		return ret, fmt.Errorf(response.ErrorMessage)
+		return ret, response
 	}
 
-	startingIP := ipmath.IP{
-		Address: net.ParseIP(ret.StartAddress),
-	}
-	count := startingIP.Difference(net.ParseIP(ret.EndAddress)) + 1
-	ret.IPAddressList = getRangeAddressList(ret.StartAddress, count)
+	ret.IPAddressList = rangeAddressList(ret.StartAddress, ret.EndAddress)
 
 	return ret, nil
 }
 
 // GetRangeByQuery gets range by query
 func (c *Client) GetRangeByQuery(queryParams map[string]string) ([]Range, error) {
-	var ret RangeQueryResult
+	return c.GetRangeByQueryWithContext(context.Background(), queryParams)
+}
 
-	queryParams["_return_fields"] = rangeReturnFields
-	queryParams["_return_as_object"] = "1"
-	queryParams["_paging"] = "0"
-	queryParams["_max_results"] = "2"
+// GetRangeByQueryWithContext gets range by query using the supplied context
+func (c *Client) GetRangeByQueryWithContext(ctx context.Context, queryParams map[string]string) ([]Range, error) {
+	return c.ListRangesWithContext(ctx, queryParams)
+}
 
-	queryParamString := c.BuildQuery(queryParams)
-	request, err := c.CreateJSONRequest(http.MethodGet, fmt.Sprintf("%s?%s", rangeBasePath, queryParamString), nil)
+// ListRanges lists all ranges matching query parameters following every result page
+func (c *Client) ListRanges(queryParams map[string]string) ([]Range, error) {
+	return c.ListRangesWithContext(context.Background(), queryParams)
+}
+
+// ListRangesWithContext lists all ranges matching query parameters following every result page using the supplied context
+func (c *Client) ListRangesWithContext(ctx context.Context, queryParams map[string]string) ([]Range, error) {
+	ret, err := listWithReturnFields[Range](ctx, c, rangeBasePath, rangeReturnFields, queryParams)
 	if err != nil {
 		return nil, err
 	}
 
-	response := c.Call(request, &ret)
-	if response != nil {
-		return nil, fmt.Errorf(response.ErrorMessage)
+	for i, r := range ret {
+		ret[i].IPAddressList = rangeAddressList(r.StartAddress, r.EndAddress)
 	}
 
-	for i, r := range ret.Results {
-		startingIP := ipmath.IP{
-			Address: net.ParseIP(r.StartAddress),
-		}
-		count := startingIP.Difference(net.ParseIP(r.EndAddress)) + 1
-		ret.Results[i].IPAddressList = getRangeAddressList(r.StartAddress, count)
-	}
+	return ret, nil
+}
 
-	return ret.Results, nil
+// rangeAddressList lists the addresses from startAddress to endAddress.  It is
+// empty when either address was not returned
+func rangeAddressList(startAddress string, endAddress string) []string {
+	if startAddress == "" || endAddress == "" {
+		return []string{}
+	}
+	startingIP := ipmath.IP{
+		Address: net.ParseIP(startAddress),
+	}
+	return getRangeAddressList(startAddress, startingIP.Difference(net.ParseIP(endAddress))+1)
 }
 
 func getRangeAddressList(startAddress string, count int) []string {
@@ -93,6 +100,11 @@ func getRangeAddressList(startAddress string, count int) []string {
 
 // GetPaginatedCidrRanges gets ranges within CIDR by page
 func (c *Client) GetPaginatedCidrRanges(cidr string, pageID string) (rangePage RangeQueryResult, err error) {
+	return c.GetPaginatedCidrRangesWithContext(context.Background(), cidr, pageID)
+}
+
+// GetPaginatedCidrRangesWithContext gets ranges within CIDR by page using the supplied context
+func (c *Client) GetPaginatedCidrRangesWithContext(ctx context.Context, cidr string, pageID string) (rangePage RangeQueryResult, err error) {
 	var ret RangeQueryResult
 
 	queryParams := map[string]string{
@@ -105,16 +117,17 @@ func (c *Client) GetPaginatedCidrRanges(cidr string, pageID string) (rangePage R
 	if pageID != "" {
 		queryParams["_page_id"] = pageID
 	}
+	queryParams = c.withDefaultView(rangeBasePath, queryParams)
 
 	queryParamString := c.BuildQuery(queryParams)
-	request, err := c.CreateJSONRequest(http.MethodGet, fmt.Sprintf("%s?%s", rangeBasePath, queryParamString), nil)
+	request, err := c.CreateJSONRequestWithContext(ctx, http.MethodGet, fmt.Sprintf("%s?%s", rangeBasePath, queryParamString), nil)
 	if err != nil {
 		return rangePage, err
 	}
 
 	response := c.Call(request, &ret)
 	if response != nil {
-		return rangePage, fmt.Errorf(response.ErrorMessage)
+		return rangePage, response
 	}
 
 	return ret, nil
@@ -122,74 +135,91 @@ func (c *Client) GetPaginatedCidrRanges(cidr string, pageID string) (rangePage R
 
 // CreateRange creates range
 func (c *Client) CreateRange(rangeObject *Range) error {
+	return c.CreateRangeWithContext(context.Background(), rangeObject)
+}
+
+// CreateRangeWithContext creates range using the supplied context
+func (c *Client) CreateRangeWithContext(ctx context.Context, rangeObject *Range) error {
+	rangeObject.NetworkView = c.networkView(rangeObject.NetworkView)
 	queryParams := map[string]string{
 		"_return_fields": rangeReturnFields,
 	}
 	queryParamString := c.BuildQuery(queryParams)
 	rangeObject.IPAddressList = []string{}
-	request, err := c.CreateJSONRequest(http.MethodPost, fmt.Sprintf("%s?%s", rangeBasePath, queryParamString), rangeObject)
+	request, err := c.CreateJSONRequestWithContext(ctx, http.MethodPost, fmt.Sprintf("%s?%s", rangeBasePath, queryParamString), rangeObject)
 	if err != nil {
 		return err
 	}
 
 	response := c.Call(request, &rangeObject)
 	if response != nil {
-		return fmt.Errorf(response.ErrorMessage)
-	}
-	startingIP := ipmath.IP{
-		Address: net.ParseIP(rangeObject.StartAddress),
+		return response
 	}
-	count := startingIP.Difference(net.ParseIP(rangeObject.EndAddress)) + 1
-	rangeObject.IPAddressList = getRangeAddressList(rangeObject.StartAddress, count)
+	rangeObject.IPAddressList = rangeAddressList(rangeObject.StartAddress, rangeObject.EndAddress)
 	return nil
 }
 
 // UpdateRange updates range
 func (c *Client) UpdateRange(ref string, rangeObject Range) (Range, error) {
+	return c.UpdateRangeWithContext(context.Background(), ref, rangeObject)
+}
+
+// UpdateRangeWithContext updates range using the supplied context
+func (c *Client) UpdateRangeWithContext(ctx context.Context, ref string, rangeObject Range) (Range, error) {
 	var ret Range
 	queryParams := map[string]string{
 		"_return_fields": rangeReturnFields,
 	}
 	queryParamString := c.BuildQuery(queryParams)
-	request, err := c.CreateJSONRequest(http.MethodPut, fmt.Sprintf("%s?%s", ref, queryParamString), rangeObject)
+	request, err := c.CreateJSONRequestWithContext(ctx, http.MethodPut, fmt.Sprintf("%s?%s", ref, queryParamString), rangeObject)
 	if err != nil {
 		return ret, err
 	}
 
 	response := c.Call(request, &ret)
 	if response != nil {
-		return ret, fmt.Errorf(response.ErrorMessage)
+		return ret, response
 	}
 	return ret, nil
 }
 
 // DeleteRange deletes range
 func (c *Client) DeleteRange(ref string) error {
-	request, err := c.CreateJSONRequest(http.MethodDelete, ref, nil)
+	return c.DeleteRangeWithContext(context.Background(), ref)
+}
+
+// DeleteRangeWithContext deletes range using the supplied context
+func (c *Client) DeleteRangeWithContext(ctx context.Context, ref string) error {
+	request, err := c.CreateJSONRequestWithContext(ctx, http.MethodDelete, ref, nil)
 	if err != nil {
 		return err
 	}
 
 	response := c.Call(request, nil)
 	if response != nil {
-		if response.StatusCode == 404 {
+		if IsNotFound(response) {
 			return nil
 		}
-		return err
+		return response
 	}
 	return nil
 }
 
 // CreateSequentialRange creates sequential address range
 func (c *Client) CreateSequentialRange(rangeObject *Range, query AddressQuery) error {
+	return c.CreateSequentialRangeWithContext(context.Background(), rangeObject, query)
+}
+
+// CreateSequentialRangeWithContext creates sequential address range using the supplied context
+func (c *Client) CreateSequentialRangeWithContext(ctx context.Context, rangeObject *Range, query AddressQuery) error {
 	c.SequentialLock.Lock()
 	defer c.SequentialLock.Unlock()
-	query.fillDefaults()
+	query.fillDefaults(c.config.NetworkView)
 	retryCount := 0
 	verified := false
 	for !verified && retryCount <= query.Retries {
 		log.Println("Getting sequential range")
-		sequentialAddresses, err := c.GetSequentialAddressRange(query)
+		sequentialAddresses, err := c.GetSequentialAddressRangeWithContext(ctx, query)
 		if err != nil {
 			log.Printf("The following error occurred while getting sequential address range: %s", err)
 			return err
@@ -198,19 +228,25 @@ func (c *Client) CreateSequentialRange(rangeObject *Range, query AddressQuery) e
 		rangeObject.EndAddress = (*sequentialAddresses)[len(*sequentialAddresses)-1].IPAddress
 
 		log.Println("Creating range")
-		time.Sleep(10 * time.Second)
-		err = c.CreateRange(rangeObject)
+		if err := sleepWithContext(ctx, 10*time.Second); err != nil {
+			return err
+		}
+		err = c.CreateRangeWithContext(ctx, rangeObject)
 		if err != nil {
 			verified = false
-			time.Sleep(2 * time.Second)
-			retryCount++
 			log.Printf("An error occurred creating range: %s", err)
+			if err := sleepWithContext(ctx, 2*time.Second); err != nil {
+				return err
+			}
+			retryCount++
 		} else {
 			log.Println("Pausing for race condition checks")
-			time.Sleep(1 * time.Second)
+			if err := sleepWithContext(ctx, 1*time.Second); err != nil {
+				return err
+			}
 
 			// Check for used addresses within range
-			usedAddresses, err := c.GetUsedAddressesWithinRange(AddressQuery{
+			usedAddresses, err := c.GetUsedAddressesWithinRangeWithContext(ctx, AddressQuery{
 				CIDR:                 query.CIDR,
 				StartAddress:         rangeObject.StartAddress,
 				EndAddress:           rangeObject.EndAddress,
@@ -222,12 +258,14 @@ func (c *Client) CreateSequentialRange(rangeObject *Range, query AddressQuery) e
 			if len((*usedAddresses)) > 0 {
 				log.Println("Found allocated addresses within newly created range.  Deleting and Recreating.....")
 				retryCount++
-				err := c.DeleteRange(rangeObject.Ref)
+				err := c.DeleteRangeWithContext(ctx, rangeObject.Ref)
 				if err != nil {
 					log.Printf("The following error occurred deleting range: %s", err)
 					return err
 				}
-				time.Sleep(time.Duration(rand.Intn(5)) * time.Second)
+				if err := sleepWithContext(ctx, time.Duration(rand.Intn(5))*time.Second); err != nil {
+					return err
+				}
 			} else {
 				verified = true
 			}
@@ -243,58 +281,40 @@ func (c *Client) CreateSequentialRange(rangeObject *Range, query AddressQuery) e
 
 // CheckIfRangeContainsRange checks if a range exists containing ip range
 func (c *Client) CheckIfRangeContainsRange(query IPsWithinRangeQuery) (bool, error) {
-	var ret RangeQueryResult
-
-	queryParams := map[string]string{
-		"network":           query.CIDR,
-		"_return_as_object": "1",
-		"_paging":           "1",
-		"_max_results":      "100",
-		"_return_fields":    rangeReturnFields,
-	}
-	queryParamString := c.BuildQuery(queryParams)
-
-	request, err := c.CreateJSONRequest(http.MethodGet, fmt.Sprintf("%s?%s", rangeBasePath, queryParamString), nil)
+	return c.CheckIfRangeContainsRangeWithContext(context.Background(), query)
+}
 
-	if err != nil {
-		return true, err
-	}
+// CheckIfRangeContainsRangeWithContext checks if a range exists containing ip range using the supplied context
+func (c *Client) CheckIfRangeContainsRangeWithContext(ctx context.Context, query IPsWithinRangeQuery) (bool, error) {
+	return c.rangeOverlapsExisting(ctx, rangeBasePath, query)
+}
 
-	response := c.Call(request, &ret)
-	if response != nil {
-		return true, fmt.Errorf(response.ErrorMessage)
-	}
+// rangeBounds holds the fields needed to compare ipv4 and ipv6 ranges
+type rangeBounds struct {
+	Ref          string `json:"_ref,omitempty"`
+	StartAddress string `json:"start_addr,omitempty"`
+	EndAddress   string `json:"end_addr,omitempty"`
+}
 
-	if len(ret.Results) == 0 {
-		return false, nil
+// rangeOverlapsExisting checks every range of the supplied type within the
+// query network for overlap with the queried addresses
+func (c *Client) rangeOverlapsExisting(ctx context.Context, basePath string, query IPsWithinRangeQuery) (bool, error) {
+	errFound := errors.New("overlapping range found")
+	queryParams := map[string]string{
+		"network":        query.CIDR,
+		"_return_fields": "start_addr,end_addr",
 	}
-
-	matchFlag := false
-
-	for !matchFlag {
-		for _, addressRange := range ret.Results {
-			if addressRange.Ref != query.Ref && (ipWithinRange(addressRange.StartAddress, addressRange.EndAddress, query.StartAddress) || ipWithinRange(addressRange.StartAddress, addressRange.EndAddress, query.EndAddress)) {
-				matchFlag = true
-				break
-			}
-		}
-		if !matchFlag && ret.NextPageID != "" {
-			queryParams["_page_id"] = ret.NextPageID
-			queryParamString := c.BuildQuery(queryParams)
-
-			request, err := c.CreateJSONRequest(http.MethodGet, fmt.Sprintf("%s?%s", rangeBasePath, queryParamString), nil)
-			if err != nil {
-				return true, err
-			}
-
-			response := c.Call(request, &ret)
-			if response != nil {
-				return true, fmt.Errorf(response.ErrorMessage)
-			}
-		} else if !matchFlag && ret.NextPageID == "" {
-			return false, nil
+	err := ForEach(ctx, c, basePath, queryParams, 100, func(addressRange rangeBounds) error {
+		if addressRange.Ref != query.Ref && rangesOverlap(addressRange.StartAddress, addressRange.EndAddress, query.StartAddress, query.EndAddress) {
+			return errFound
 		}
+		return nil
+	})
+	if err == errFound {
+		return true, nil
 	}
-
-	return true, nil
+	if err != nil {
+		return true, err
+	}
+	return false, nil
 }
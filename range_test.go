@@ -5,19 +5,11 @@ package infoblox
 
 import (
 	"log"
-	"os"
 	"testing"
 )
 
 var (
-	rangeConfig = Config{
-		Host:                   os.Getenv("INFOBLOX_HOST"),
-		Port:                   os.Getenv("INFOBLOX_PORT"),
-		Username:               os.Getenv("INFOBLOX_USERNAME"),
-		Password:               os.Getenv("INFOBLOX_PASSWORD"),
-		Version:                os.Getenv("INFOBLOX_VERSION"),
-		DisableTLSVerification: true,
-	}
+	rangeConfig = testConfig()
 	rangeClient = New(rangeConfig)
 	testRange   = Range{
 		CIDR:         "172.19.10.0/24",
@@ -147,6 +139,7 @@ func getRange(t *testing.T) {
 	if err != nil {
 		t.Errorf("Error retrieving range: %s", err)
 	}
+	prettyPrint(rangeObject)
 }
 
 func updateRange(t *testing.T) {
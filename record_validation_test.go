@@ -0,0 +1,83 @@
+package infoblox
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestRecordValidation(t *testing.T) {
+	sha256 := strings.Repeat("ab", 32)
+	tests := []struct {
+		name   string
+		record interface{ Validate() error }
+		valid  bool
+	}{
+		{"caa issue", CAARecord{CAFlag: newInt(0), CATag: "issue", CAValue: "letsencrypt.org"}, true},
+		{"caa critical flag", CAARecord{CAFlag: newInt(128), CATag: "issuewild", CAValue: ";"}, true},
+		{"caa flag out of range", CAARecord{CAFlag: newInt(256), CATag: "issue"}, false},
+		{"caa tag with punctuation", CAARecord{CATag: "issue-wild"}, false},
+		{"caa tag too long", CAARecord{CATag: "abcdefghijklmnop"}, false},
+		{"caa iodef mailto", CAARecord{CATag: "iodef", CAValue: "mailto:security@example.com"}, true},
+		{"caa iodef not a url", CAARecord{CATag: "iodef", CAValue: "security@example.com"}, false},
+		{"naptr sip", NAPTRRecord{Order: newInt(100), Preference: newInt(10), Flags: newString("S"), Replacement: "_sip._udp.example.com"}, true},
+		{"naptr flags", NAPTRRecord{Flags: newString("U!")}, false},
+		{"naptr order out of range", NAPTRRecord{Order: newInt(65536)}, false},
+		{"naptr regexp and replacement", NAPTRRecord{Regexp: newString("!^.*$!sip:info@example.com!"), Replacement: "example.com"}, false},
+		{"naptr regexp with empty replacement", NAPTRRecord{Regexp: newString("!^.*$!sip:info@example.com!"), Replacement: "."}, true},
+		{"tlsa sha256", TLSARecord{CertificateUsage: newInt(3), Selector: newInt(1), MatchedType: newInt(1), CertificateData: sha256}, true},
+		{"tlsa usage out of range", TLSARecord{CertificateUsage: newInt(4)}, false},
+		{"tlsa selector out of range", TLSARecord{Selector: newInt(2)}, false},
+		{"tlsa matched type out of range", TLSARecord{MatchedType: newInt(3)}, false},
+		{"tlsa data not hex", TLSARecord{MatchedType: newInt(0), CertificateData: "xyz"}, false},
+		{"tlsa sha512 wrong length", TLSARecord{MatchedType: newInt(2), CertificateData: sha256}, false},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := test.record.Validate()
+			if test.valid && err != nil {
+				t.Errorf("Expected record to be valid, got %s", err)
+			}
+			if !test.valid && !IsValidationError(err) {
+				t.Errorf("Expected a validation error, got %v", err)
+			}
+		})
+	}
+}
+
+func TestCreateInvalidRecordNotSent(t *testing.T) {
+	client := New(Config{Host: "127.0.0.1", Port: "1", Version: "2.11"})
+	err := client.CreateTLSARecord(&TLSARecord{Name: "_443._tcp.example.com", CertificateUsage: newInt(9)})
+	if !IsValidationError(err) {
+		t.Errorf("Expected a validation error before sending, got %v", err)
+	}
+}
+
+func TestQuoteTXT(t *testing.T) {
+	long := strings.Repeat("a", 300)
+	tests := []struct {
+		name     string
+		text     string
+		expected string
+	}{
+		{"short", "v=spf1 -all", `"v=spf1 -all"`},
+		{"short with quotes", `say "hi" \o/`, `"say \"hi\" \\o/"`},
+		{"already quoted", `"v=spf1" "-all"`, `"v=spf1" "-all"`},
+		{"quoted prefix", `"a" b` + long, `"\"a\" b` + long[:250] + `" "` + long[250:] + `"`},
+		{"long", long, `"` + long[:255] + `" "` + long[255:] + `"`},
+		{"empty", "", ""},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			quoted := QuoteTXT(test.text)
+			if quoted != test.expected {
+				t.Errorf("Expected %s, got %s", test.expected, quoted)
+			}
+			if test.name != "already quoted" && UnquoteTXT(quoted) != test.text {
+				t.Errorf("Expected %s to unquote to %s, got %s", quoted, test.text, UnquoteTXT(quoted))
+			}
+		})
+	}
+	if unquoted := UnquoteTXT(`"a" b`); unquoted != `"a" b` {
+		t.Errorf("Expected partially quoted text to be returned unchanged, got %s", unquoted)
+	}
+}
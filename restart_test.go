@@ -0,0 +1,151 @@
+package infoblox
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/techBeck03/infoblox-go-sdk/infobloxtest"
+)
+
+func newRestartTestClient(t *testing.T, polls int) (*Client, *infobloxtest.Server, Grid) {
+	t.Helper()
+	server := infobloxtest.NewServer()
+	server.RestartPolls = polls
+	t.Cleanup(server.Close)
+	client := New(Config{
+		Host:                   server.Host(),
+		Port:                   server.Port(),
+		Version:                infobloxtest.Version,
+		DisableTLSVerification: true,
+	})
+	grids, err := client.GetGridsByQuery(nil)
+	if err != nil {
+		t.Fatalf("Error retrieving grids: %s", err)
+	}
+	return client, server, grids[0]
+}
+
+func TestRestartServicesSendsRequest(t *testing.T) {
+	client, server, grid := newRestartTestClient(t, 0)
+	members, err := client.GetGridMembersByQuery(nil)
+	if err != nil {
+		t.Fatalf("Error retrieving grid members: %s", err)
+	}
+	delay := 10
+	err = client.RestartServices(grid.Ref, GridServiceRestartRequest{
+		RestartOption:   RestartOptionForce,
+		Services:        []string{"DNS"},
+		Members:         GridMemberHostnames(members),
+		Mode:            RestartModeSequential,
+		SequentialDelay: &delay,
+	})
+	if err != nil {
+		t.Fatalf("Error restarting grid services: %s", err)
+	}
+	restarts := server.Restarts()
+	if len(restarts) != 1 {
+		t.Fatalf("Expected 1 restart request, got %d", len(restarts))
+	}
+	if restarts[0]["restart_option"] != RestartOptionForce || restarts[0]["mode"] != RestartModeSequential ||
+		fmt.Sprint(restarts[0]["members"]) != "[infoblox.localdomain]" {
+		t.Errorf("Unexpected restart request body %v", restarts[0])
+	}
+
+	err = client.RestartServices(grid.Ref, GridServiceRestartRequest{Members: []string{"missing.localdomain"}})
+	if err == nil {
+		t.Errorf("Expected restart of unknown member to fail")
+	}
+}
+
+func TestWaitForRestart(t *testing.T) {
+	client, server, grid := newRestartTestClient(t, 3)
+	if err := client.RestartServices(grid.Ref, GridServiceRestartRequest{RestartOption: RestartOptionIfNeeded}); err != nil {
+		t.Fatalf("Error restarting grid services: %s", err)
+	}
+	status, err := client.GetRestartStatus()
+	if err != nil {
+		t.Fatalf("Error retrieving restart status: %s", err)
+	}
+	if status.Done() || len(status.Members) != 1 || status.Members[0].DNSStatus != "RESTARTING" {
+		t.Errorf("Expected restart to be in progress, got %+v", status)
+	}
+
+	status, err = client.WaitForRestart(time.Millisecond)
+	if err != nil {
+		t.Fatalf("Error waiting for restart: %s", err)
+	}
+	if !status.Done() || status.Grid.Success != 1 {
+		t.Errorf("Expected restart to have completed, got %+v", status.Grid)
+	}
+
+	server.RestartPolls = 1000
+	if err := client.RestartServices(grid.Ref, GridServiceRestartRequest{}); err != nil {
+		t.Fatalf("Error restarting grid services: %s", err)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	_, err = client.WaitForRestartWithContext(ctx, 10*time.Millisecond)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Expected wait to stop at the deadline, got %v", err)
+	}
+}
+
+func TestRestartPendingServices(t *testing.T) {
+	client, server, grid := newRestartTestClient(t, 0)
+
+	err := client.CreateHostRecord(&HostRecord{
+		Hostname:        "auto.example.com",
+		EnableDNS:       newBool(true),
+		RestartIfNeeded: newBool(true),
+		IPv4Addrs:       []IPv4Addr{{IPAddress: "10.0.0.5"}},
+	})
+	if err != nil {
+		t.Fatalf("Error creating host record: %s", err)
+	}
+	pending, err := client.GetPendingChanges()
+	if err != nil {
+		t.Fatalf("Error retrieving pending changes: %s", err)
+	}
+	if len(pending.Requests) != 0 {
+		t.Errorf("Expected no pending restarts after restart_if_needed change, got %v", pending.Requests)
+	}
+
+	record := ARecord{Hostname: "pending.example.com", IPAddress: "10.0.0.10"}
+	if err := client.CreateARecord(&record); err != nil {
+		t.Fatalf("Error creating A record: %s", err)
+	}
+	pending, err = client.GetPendingChanges()
+	if err != nil {
+		t.Fatalf("Error retrieving pending changes: %s", err)
+	}
+	if len(pending.ChangedObjects) != 1 || pending.ChangedObjects[0].Action != "ADD" ||
+		pending.ChangedObjects[0].ObjectName != "pending.example.com" {
+		t.Errorf("Unexpected changed objects %+v", pending.ChangedObjects)
+	}
+	if services := pending.ServicesByMember(); fmt.Sprint(services) != "map[infoblox.localdomain:[DNS]]" {
+		t.Errorf("Unexpected pending services %v", services)
+	}
+
+	members, err := client.RestartPendingServices(grid)
+	if err != nil {
+		t.Fatalf("Error restarting pending services: %s", err)
+	}
+	if len(members) != 1 || members[0].Hostname != "infoblox.localdomain" {
+		t.Errorf("Unexpected restarted members %v", members)
+	}
+	restarts := server.Restarts()
+	if len(restarts) != 1 || fmt.Sprint(restarts[0]["services"]) != "[DNS]" {
+		t.Errorf("Unexpected restart requests %v", restarts)
+	}
+
+	members, err = client.RestartPendingServices(grid)
+	if err != nil {
+		t.Fatalf("Error restarting pending services: %s", err)
+	}
+	if len(members) != 0 || len(server.Restarts()) != 1 {
+		t.Errorf("Expected no restart without pending changes, got %v", members)
+	}
+}
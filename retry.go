@@ -0,0 +1,182 @@
+package infoblox
+
+import (
+	"errors"
+	"io"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"syscall"
+	"time"
+)
+
+const (
+	defaultRetryMaxAttempts    = 3
+	defaultRetryInitialBackoff = 500 * time.Millisecond
+	defaultRetryMaxBackoff     = 30 * time.Second
+)
+
+// RetryPolicy defines how failed requests are retried by Call
+type RetryPolicy struct {
+	// MaxAttempts is the total number of attempts including the first one
+	MaxAttempts int
+	// InitialBackoff is the base delay used for exponential backoff
+	InitialBackoff time.Duration
+	// MaxBackoff caps the delay between attempts, including delays requested
+	// by the grid with Retry-After
+	MaxBackoff time.Duration
+	// RetryableStatusCodes lists response codes that trigger a retry
+	RetryableStatusCodes []int
+	// RetryConnectionErrors retries connection resets, refusals and dropped connections
+	RetryConnectionErrors bool
+	// RetryNonIdempotent allows retrying methods such as POST
+	RetryNonIdempotent bool
+}
+
+// DefaultRetryPolicy returns a retry policy suitable for most grids
+func DefaultRetryPolicy() *RetryPolicy {
+	return &RetryPolicy{
+		MaxAttempts:    defaultRetryMaxAttempts,
+		InitialBackoff: defaultRetryInitialBackoff,
+		MaxBackoff:     defaultRetryMaxBackoff,
+		RetryableStatusCodes: []int{
+			http.StatusTooManyRequests,
+			http.StatusBadGateway,
+			http.StatusServiceUnavailable,
+			http.StatusGatewayTimeout,
+		},
+		RetryConnectionErrors: true,
+	}
+}
+
+func (p *RetryPolicy) maxAttempts() int {
+	if p == nil || p.MaxAttempts < 1 {
+		return 1
+	}
+	return p.MaxAttempts
+}
+
+func (p *RetryPolicy) allowsMethod(method string) bool {
+	if p.RetryNonIdempotent {
+		return true
+	}
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	}
+	return false
+}
+
+func (p *RetryPolicy) retryableStatus(statusCode int) bool {
+	for _, code := range p.RetryableStatusCodes {
+		if code == statusCode {
+			return true
+		}
+	}
+	return false
+}
+
+func (p *RetryPolicy) retryableError(err error) bool {
+	if !p.RetryConnectionErrors {
+		return false
+	}
+	return errors.Is(err, syscall.ECONNRESET) ||
+		errors.Is(err, syscall.ECONNREFUSED) ||
+		errors.Is(err, syscall.EPIPE) ||
+		errors.Is(err, io.EOF) ||
+		errors.Is(err, io.ErrUnexpectedEOF)
+}
+
+func (p *RetryPolicy) maxBackoff() time.Duration {
+	if p.MaxBackoff <= 0 {
+		return defaultRetryMaxBackoff
+	}
+	return p.MaxBackoff
+}
+
+// backoff returns the delay before the supplied retry attempt using
+// exponential backoff with full jitter
+func (p *RetryPolicy) backoff(attempt int) time.Duration {
+	initial := p.InitialBackoff
+	if initial <= 0 {
+		initial = defaultRetryInitialBackoff
+	}
+	ceiling := p.maxBackoff()
+	delay := initial << uint(attempt)
+	if delay <= 0 || delay > ceiling {
+		delay = ceiling
+	}
+	return time.Duration(rand.Int63n(int64(delay) + 1))
+}
+
+// retryAfter parses the Retry-After header as either seconds or an http date
+func retryAfter(response *http.Response) (time.Duration, bool) {
+	header := response.Header.Get("Retry-After")
+	if header == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(header); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+	if date, err := http.ParseTime(header); err == nil {
+		delay := time.Until(date)
+		if delay < 0 {
+			delay = 0
+		}
+		return delay, true
+	}
+	return 0, false
+}
+
+// do sends the request applying the configured retry policy
+func (c *Client) do(request *http.Request) (*http.Response, error) {
+	if c.err != nil {
+		return nil, c.err
+	}
+	policy := c.config.RetryPolicy
+	attempts := policy.maxAttempts()
+	if attempts > 1 && !policy.allowsMethod(request.Method) {
+		attempts = 1
+	}
+	ctx := request.Context()
+
+	for attempt := 0; ; attempt++ {
+		attemptRequest := request
+		if attempt > 0 {
+			var err error
+			attemptRequest, err = cloneRequest(request)
+			if err != nil {
+				return nil, err
+			}
+		}
+		response, err := c.client.Do(attemptRequest)
+		if attempt+1 >= attempts || ctx.Err() != nil {
+			return response, err
+		}
+
+		var delay time.Duration
+		if err != nil {
+			if !policy.retryableError(err) {
+				return response, err
+			}
+			delay = policy.backoff(attempt)
+		} else {
+			if !policy.retryableStatus(response.StatusCode) {
+				return response, nil
+			}
+			delay = policy.backoff(attempt)
+			if wait, ok := retryAfter(response); ok {
+				delay = wait
+				if ceiling := policy.maxBackoff(); delay > ceiling {
+					delay = ceiling
+				}
+			}
+			io.Copy(io.Discard, response.Body)
+			response.Body.Close()
+		}
+
+		if err := sleepWithContext(ctx, delay); err != nil {
+			return nil, err
+		}
+	}
+}
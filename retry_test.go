@@ -0,0 +1,106 @@
+package infoblox
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func newRetryTestClient(server *httptest.Server, policy *RetryPolicy) *Client {
+	client := New(Config{
+		Host:        strings.TrimPrefix(server.URL, "https://"),
+		Version:     "2.11",
+		RetryPolicy: policy,
+	})
+	client.client = server.Client()
+	client.baseURL = server.URL + "/wapi/v2.11"
+	return client
+}
+
+func TestRetryPolicyRetriesUnavailable(t *testing.T) {
+	var calls int32
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&calls, 1) < 3 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.Write([]byte(`{"_ref":"network/abc:10.0.0.0/24/default","network":"10.0.0.0/24"}`))
+	}))
+	defer server.Close()
+
+	policy := DefaultRetryPolicy()
+	policy.InitialBackoff = time.Millisecond
+	client := newRetryTestClient(server, policy)
+	network, err := client.GetNetworkByRef("network/abc:10.0.0.0/24/default", nil)
+	if err != nil {
+		t.Fatalf("Error retrieving network: %s", err)
+	}
+	if network.CIDR != "10.0.0.0/24" {
+		t.Errorf("Unexpected network returned: %+v", network)
+	}
+	if calls != 3 {
+		t.Errorf("Expected 3 attempts, got %d", calls)
+	}
+}
+
+func TestRetryPolicySkipsNonIdempotent(t *testing.T) {
+	var calls int32
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	policy := DefaultRetryPolicy()
+	policy.InitialBackoff = time.Millisecond
+	client := newRetryTestClient(server, policy)
+	err := client.CreateNetwork(&Network{CIDR: "10.0.0.0/24"})
+	if err == nil {
+		t.Fatalf("Expected error creating network")
+	}
+	if calls != 1 {
+		t.Errorf("Expected 1 attempt, got %d", calls)
+	}
+}
+
+func TestRetryAfterHeader(t *testing.T) {
+	response := &http.Response{Header: http.Header{}}
+	if _, ok := retryAfter(response); ok {
+		t.Errorf("Expected no Retry-After value")
+	}
+	response.Header.Set("Retry-After", "7")
+	if delay, ok := retryAfter(response); !ok || delay != 7*time.Second {
+		t.Errorf("Unexpected Retry-After value %s", delay)
+	}
+}
+
+func TestRetryAfterCappedByMaxBackoff(t *testing.T) {
+	var calls int32
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&calls, 1) < 2 {
+			w.Header().Set("Retry-After", "3600")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.Write([]byte(`{"_ref":"network/abc:10.0.0.0/24/default","network":"10.0.0.0/24"}`))
+	}))
+	defer server.Close()
+
+	policy := DefaultRetryPolicy()
+	policy.MaxBackoff = 10 * time.Millisecond
+	client := newRetryTestClient(server, policy)
+	start := time.Now()
+	if _, err := client.GetNetworkByRef("network/abc:10.0.0.0/24/default", nil); err != nil {
+		t.Fatalf("Error retrieving network: %s", err)
+	}
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Errorf("Expected Retry-After to be capped by MaxBackoff, waited %s", elapsed)
+	}
+	if calls != 2 {
+		t.Errorf("Expected 2 attempts, got %d", calls)
+	}
+}
@@ -0,0 +1,299 @@
+package infoblox
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const (
+	// schemaDiscoveryVersion is the WAPI version used to request the grid
+	// schema when no version is configured, every grid supports it
+	schemaDiscoveryVersion = "1.0"
+)
+
+// FieldCheck controls how fields of created and updated objects that are not
+// supported by the WAPI version in use are reported
+type FieldCheck int
+
+const (
+	// FieldCheckOff sends objects without checking their fields
+	FieldCheckOff FieldCheck = iota
+	// FieldCheckWarn logs unsupported fields to Config.Logger, or the
+	// standard logger when it is nil, and sends the object
+	FieldCheckWarn
+	// FieldCheckError fails with ErrValidation before sending the object
+	FieldCheckError
+)
+
+// Schema is the grid schema returned by ?_schema
+type Schema struct {
+	RequestedVersion        string   `json:"requested_version"`
+	SupportedVersions       []string `json:"supported_versions"`
+	SupportedObjects        []string `json:"supported_objects"`
+	SupportedSchemaVersions []string `json:"supported_schema_versions"`
+}
+
+// ObjectSchema is the schema of a WAPI object type
+type ObjectSchema struct {
+	Type                        string        `json:"type"`
+	Version                     string        `json:"version"`
+	Fields                      []FieldSchema `json:"fields"`
+	Restrictions                []string      `json:"restrictions"`
+	CloudAdditionalRestrictions []string      `json:"cloud_additional_restrictions"`
+}
+
+// FieldSchema is the schema of an object field.  Supports holds the
+// operations allowed on the field, r(ead), w(rite), u(pdate) and s(earch),
+// and SearchableBy the search modifiers accepted by the field
+type FieldSchema struct {
+	Name          string   `json:"name"`
+	Type          []string `json:"type"`
+	IsArray       bool     `json:"is_array"`
+	Supports      string   `json:"supports"`
+	SearchableBy  string   `json:"searchable_by"`
+	StandardField bool     `json:"standard_field"`
+}
+
+// HighestVersion returns the highest WAPI version supported by the grid
+func (s Schema) HighestVersion() string {
+	var highest string
+	for _, version := range s.SupportedVersions {
+		if highest == "" || compareVersions(version, highest) > 0 {
+			highest = version
+		}
+	}
+	return highest
+}
+
+// SupportsObject reports whether the grid supports an object type
+func (s Schema) SupportsObject(objectType string) bool {
+	for _, object := range s.SupportedObjects {
+		if object == objectType {
+			return true
+		}
+	}
+	return false
+}
+
+// Field returns the schema of a field
+func (s ObjectSchema) Field(name string) (FieldSchema, bool) {
+	for _, field := range s.Fields {
+		if field.Name == name {
+			return field, true
+		}
+	}
+	return FieldSchema{}, false
+}
+
+// Searchable reports whether objects can be searched by the field
+func (f FieldSchema) Searchable() bool {
+	return strings.Contains(f.Supports, "s")
+}
+
+// GetSchema returns the grid schema listing the supported WAPI versions and
+// object types
+func (c *Client) GetSchema() (Schema, error) {
+	return c.GetSchemaWithContext(context.Background())
+}
+
+// GetSchemaWithContext returns the grid schema listing the supported WAPI
+// versions and object types using the supplied context
+func (c *Client) GetSchemaWithContext(ctx context.Context) (Schema, error) {
+	var ret Schema
+	request, err := c.CreateJSONRequestWithContext(ctx, http.MethodGet, "?_schema", nil)
+	if err != nil {
+		return ret, err
+	}
+	response := c.Call(request, &ret)
+	if response != nil {
+		return ret, response
+	}
+	return ret, nil
+}
+
+// GetObjectSchema returns the fields of an object type supported by the WAPI
+// version in use.  Schemas are cached for the lifetime of the client
+func (c *Client) GetObjectSchema(objectType string) (ObjectSchema, error) {
+	return c.GetObjectSchemaWithContext(context.Background(), objectType)
+}
+
+// GetObjectSchemaWithContext returns the fields of an object type supported by
+// the WAPI version in use using the supplied context
+func (c *Client) GetObjectSchemaWithContext(ctx context.Context, objectType string) (ObjectSchema, error) {
+	c.schemaLock.Lock()
+	schema, ok := c.objectSchemas[objectType]
+	c.schemaLock.Unlock()
+	if ok {
+		return schema, nil
+	}
+
+	request, err := c.CreateJSONRequestWithContext(ctx, http.MethodGet, fmt.Sprintf("%s?_schema", objectType), nil)
+	if err != nil {
+		return schema, err
+	}
+	response := c.Call(request, &schema)
+	if response != nil {
+		return schema, response
+	}
+
+	c.schemaLock.Lock()
+	defer c.schemaLock.Unlock()
+	if c.objectSchemas == nil {
+		c.objectSchemas = map[string]ObjectSchema{}
+	}
+	c.objectSchemas[objectType] = schema
+	return schema, nil
+}
+
+// Version returns the WAPI version used by the client, negotiating the
+// highest version supported by the grid when none is configured
+func (c *Client) Version() (string, error) {
+	return c.VersionWithContext(context.Background())
+}
+
+// VersionWithContext returns the WAPI version used by the client using the
+// supplied context
+func (c *Client) VersionWithContext(ctx context.Context) (string, error) {
+	if _, err := c.wapiBaseURL(ctx); err != nil {
+		return "", err
+	}
+	c.versionLock.Lock()
+	defer c.versionLock.Unlock()
+	return c.version, nil
+}
+
+// wapiBaseURL returns the versioned WAPI URL requests are sent to.  When no
+// version is configured the grid schema is requested with the discovery
+// version and the highest supported version is used from then on.  A single
+// negotiation runs at a time without holding versionLock, concurrent callers
+// wait for its result until their context is done and negotiate again if it
+// failed
+func (c *Client) wapiBaseURL(ctx context.Context) (string, error) {
+	for {
+		c.versionLock.Lock()
+		baseURL, err, negotiating := c.baseURL, c.err, c.negotiating
+		if baseURL != "" || err != nil {
+			c.versionLock.Unlock()
+			if baseURL != "" {
+				return baseURL, nil
+			}
+			return "", err
+		}
+		if negotiating == nil {
+			c.negotiating = make(chan struct{})
+			negotiating = c.negotiating
+			c.versionLock.Unlock()
+			break
+		}
+		c.versionLock.Unlock()
+
+		select {
+		case <-negotiating:
+		case <-ctx.Done():
+			return "", ctx.Err()
+		}
+	}
+
+	version, err := c.negotiateVersion(ctx)
+	c.versionLock.Lock()
+	defer c.versionLock.Unlock()
+	close(c.negotiating)
+	c.negotiating = nil
+	if err != nil {
+		return "", err
+	}
+	c.version = version
+	c.baseURL = fmt.Sprintf("https://%s:%s/wapi/v%s", c.config.Host, c.config.Port, version)
+	return c.baseURL, nil
+}
+
+// negotiateVersion requests the grid schema with the discovery version and
+// returns the highest WAPI version supported by the grid
+func (c *Client) negotiateVersion(ctx context.Context) (string, error) {
+	discoveryURL := fmt.Sprintf("https://%s:%s/wapi/v%s/?_schema", c.config.Host, c.config.Port, schemaDiscoveryVersion)
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, discoveryURL, http.NoBody)
+	if err != nil {
+		return "", err
+	}
+	var schema Schema
+	if response := c.Call(request, &schema); response != nil {
+		return "", fmt.Errorf("error negotiating WAPI version: %w", response)
+	}
+	version := schema.HighestVersion()
+	if version == "" {
+		return "", fmt.Errorf("error negotiating WAPI version: grid reported no supported versions")
+	}
+	return version, nil
+}
+
+// checkFields reports the fields of an encoded object that are not part of
+// the schema of its object type as configured by Config.FieldCheck
+func (c *Client) checkFields(ctx context.Context, objectType string, body []byte) error {
+	if c.config.FieldCheck == FieldCheckOff {
+		return nil
+	}
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(body, &fields); err != nil {
+		return nil
+	}
+	schema, err := c.GetObjectSchemaWithContext(ctx, objectType)
+	if err != nil {
+		return err
+	}
+	var unsupported []string
+	for name := range fields {
+		if strings.HasPrefix(name, "_") {
+			continue
+		}
+		// Fields such as extattrs+ and ipv4addrs- add to or remove from
+		// the field named without the suffix
+		if _, ok := schema.Field(strings.TrimRight(name, "+-")); !ok {
+			unsupported = append(unsupported, name)
+		}
+	}
+	if len(unsupported) == 0 {
+		return nil
+	}
+	sort.Strings(unsupported)
+	message := fmt.Sprintf("%s fields %s are not supported by WAPI version %s", objectType, strings.Join(unsupported, ", "), schema.Version)
+	if c.config.FieldCheck == FieldCheckError {
+		return fmt.Errorf("%w: %s", ErrValidation, message)
+	}
+	c.logger().Printf("Warning: %s", message)
+	return nil
+}
+
+// logger returns the configured logger falling back to the standard logger
+func (c *Client) logger() *log.Logger {
+	if c.config.Logger != nil {
+		return c.config.Logger
+	}
+	return log.Default()
+}
+
+// compareVersions compares dotted WAPI versions numerically
+func compareVersions(a string, b string) int {
+	aParts, bParts := strings.Split(a, "."), strings.Split(b, ".")
+	for i := 0; i < len(aParts) || i < len(bParts); i++ {
+		var aValue, bValue int
+		if i < len(aParts) {
+			aValue, _ = strconv.Atoi(aParts[i])
+		}
+		if i < len(bParts) {
+			bValue, _ = strconv.Atoi(bParts[i])
+		}
+		if aValue != bValue {
+			if aValue < bValue {
+				return -1
+			}
+			return 1
+		}
+	}
+	return 0
+}
@@ -0,0 +1,243 @@
+package infoblox
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/techBeck03/infoblox-go-sdk/infobloxtest"
+)
+
+// newSchemaTestClient returns a client negotiating its version with a fake
+// grid supporting versions up to 2.12
+func newSchemaTestClient(t *testing.T, fieldCheck FieldCheck) (*Client, *infobloxtest.Server) {
+	t.Helper()
+	server := infobloxtest.NewServer()
+	server.Versions = []string{"1.0", "2.9", "2.12", "2.10"}
+	t.Cleanup(server.Close)
+	client := New(Config{
+		Host:                   server.Host(),
+		Port:                   server.Port(),
+		DisableTLSVerification: true,
+		FieldCheck:             fieldCheck,
+	})
+	return client, server
+}
+
+func TestVersionNegotiation(t *testing.T) {
+	client, server := newSchemaTestClient(t, FieldCheckOff)
+
+	grids, err := client.GetGridsByQuery(nil)
+	if err != nil || len(grids) != 1 {
+		t.Fatalf("Error retrieving grids: %v", err)
+	}
+	version, err := client.Version()
+	if err != nil || version != "2.12" {
+		t.Errorf("Expected negotiated version 2.12, got %s %v", version, err)
+	}
+	requests := server.Requests()
+	if len(requests) != 2 || requests[0] != "GET /wapi/v1.0/?_schema" || !strings.HasPrefix(requests[1], "GET /wapi/v2.12/grid") {
+		t.Errorf("Expected a single discovery request, got %v", requests)
+	}
+
+	schema, err := client.GetSchema()
+	if err != nil {
+		t.Fatalf("Error retrieving schema: %s", err)
+	}
+	if schema.RequestedVersion != "2.12" || schema.HighestVersion() != "2.12" || !schema.SupportsObject("record:a") || schema.SupportsObject("record:bogus") {
+		t.Errorf("Unexpected schema %+v", schema)
+	}
+
+	client = New(Config{Host: server.Host(), Port: server.Port(), Version: "2.13", DisableTLSVerification: true})
+	if _, err := client.GetGridsByQuery(nil); !IsValidationError(err) {
+		t.Errorf("Expected unsupported version error, got %v", err)
+	}
+}
+
+func TestVersionNegotiationSingleFlight(t *testing.T) {
+	fake := infobloxtest.NewServer()
+	t.Cleanup(fake.Close)
+	var discoveries int32
+	discovering := make(chan struct{})
+	release := make(chan struct{})
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/wapi/v"+schemaDiscoveryVersion+"/" {
+			if atomic.AddInt32(&discoveries, 1) == 1 {
+				close(discovering)
+				<-release
+			}
+		}
+		fake.ServeHTTP(w, r)
+	}))
+	t.Cleanup(server.Close)
+	address, _ := url.Parse(server.URL)
+	client := New(Config{Host: address.Hostname(), Port: address.Port(), DisableTLSVerification: true})
+
+	var wg sync.WaitGroup
+	errs := make(chan error, 3)
+	negotiate := func() {
+		defer wg.Done()
+		_, err := client.Version()
+		errs <- err
+	}
+	wg.Add(1)
+	go negotiate()
+	<-discovering
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	waited := make(chan error, 1)
+	go func() {
+		_, err := client.VersionWithContext(ctx)
+		waited <- err
+	}()
+	select {
+	case err := <-waited:
+		if !errors.Is(err, context.DeadlineExceeded) {
+			t.Errorf("Expected the waiting caller to stop at its deadline, got %v", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Errorf("Expected a negotiation in progress not to block callers past their deadline")
+	}
+
+	wg.Add(2)
+	go negotiate()
+	go negotiate()
+	close(release)
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		if err != nil {
+			t.Errorf("Error negotiating version: %s", err)
+		}
+	}
+	if count := atomic.LoadInt32(&discoveries); count != 1 {
+		t.Errorf("Expected a single discovery request, got %d", count)
+	}
+	if version, err := client.Version(); err != nil || version != infobloxtest.Version {
+		t.Errorf("Expected negotiated version %s, got %s %v", infobloxtest.Version, version, err)
+	}
+}
+
+func TestGetObjectSchema(t *testing.T) {
+	client, server := newSchemaTestClient(t, FieldCheckOff)
+	server.SetSchema("record:a",
+		infobloxtest.SchemaField{Name: "ipv4addr", Type: []string{"string"}, Supports: "rwus", SearchableBy: "=~<>"},
+		infobloxtest.SchemaField{Name: "name", Type: []string{"string"}, Supports: "rwus", SearchableBy: "=:~"},
+		infobloxtest.SchemaField{Name: "zone", Type: []string{"string"}, Supports: "r"},
+	)
+
+	schema, err := client.GetObjectSchema("record:a")
+	if err != nil {
+		t.Fatalf("Error retrieving object schema: %s", err)
+	}
+	if schema.Type != "record:a" || schema.Version != "2.12" || len(schema.Fields) != 3 {
+		t.Errorf("Unexpected object schema %+v", schema)
+	}
+	if field, ok := schema.Field("ipv4addr"); !ok || !field.Searchable() || field.SearchableBy != "=~<>" {
+		t.Errorf("Expected searchable ipv4addr field, got %+v", field)
+	}
+	if field, ok := schema.Field("zone"); !ok || field.Searchable() {
+		t.Errorf("Expected zone field not to be searchable, got %+v", field)
+	}
+
+	before := len(server.Requests())
+	if _, err := client.GetObjectSchema("record:a"); err != nil {
+		t.Fatalf("Error retrieving cached object schema: %s", err)
+	}
+	if len(server.Requests()) != before {
+		t.Errorf("Expected object schema to be cached")
+	}
+}
+
+func TestFieldCheck(t *testing.T) {
+	fields := []infobloxtest.SchemaField{
+		{Name: "ipv4addr", Supports: "rwus"},
+		{Name: "name", Supports: "rwus"},
+		{Name: "view", Supports: "rwus"},
+	}
+
+	client, server := newSchemaTestClient(t, FieldCheckError)
+	server.SetSchema("record:a", fields...)
+	record := ARecord{Hostname: "www.example.com", IPAddress: "10.1.1.10", TTL: newInt(300), Comment: "web"}
+	err := client.CreateARecord(&record)
+	if !IsValidationError(err) || !strings.Contains(err.Error(), "record:a fields comment, ttl are not supported by WAPI version 2.12") {
+		t.Errorf("Expected unsupported field error, got %v", err)
+	}
+	batch := NewBatch()
+	batch.Create(&ARecord{Hostname: "www.example.com", IPAddress: "10.1.1.10", TTL: newInt(300)})
+	if _, err := client.ExecuteBatch(batch); !IsValidationError(err) {
+		t.Errorf("Expected unsupported field error for batch, got %v", err)
+	}
+	if records := server.Objects("record:a"); len(records) != 0 {
+		t.Errorf("Expected no A record to be created, got %v", records)
+	}
+	if err := client.CreateARecord(&ARecord{Hostname: "www.example.com", IPAddress: "10.1.1.10"}); err != nil {
+		t.Errorf("Error creating A record with supported fields: %s", err)
+	}
+
+	client, server = newSchemaTestClient(t, FieldCheckWarn)
+	server.SetSchema("record:a", fields...)
+	var output bytes.Buffer
+	client.config.Logger = log.New(&output, "", 0)
+	record = ARecord{Hostname: "www.example.com", IPAddress: "10.1.1.10", TTL: newInt(300)}
+	if err := client.CreateARecord(&record); err != nil {
+		t.Fatalf("Error creating A record: %s", err)
+	}
+	if !strings.Contains(output.String(), "record:a fields ttl are not supported") {
+		t.Errorf("Expected unsupported field warning, got %q", output.String())
+	}
+}
+
+func TestFieldCheckAddRemoveFields(t *testing.T) {
+	client, server := newSchemaTestClient(t, FieldCheckError)
+	server.SetSchema("record:a",
+		infobloxtest.SchemaField{Name: "ipv4addr", Supports: "rwus"},
+		infobloxtest.SchemaField{Name: "name", Supports: "rwus"},
+		infobloxtest.SchemaField{Name: "view", Supports: "rwus"},
+		infobloxtest.SchemaField{Name: "extattrs", Supports: "rwu"},
+	)
+	record := ARecord{Hostname: "www.example.com", IPAddress: "10.1.1.10"}
+	if err := client.CreateARecord(&record); err != nil {
+		t.Fatalf("Error creating A record: %s", err)
+	}
+	ea := ExtensibleAttribute{"Owner": ExtensibleAttributeValue{Value: "testUser"}}
+	if _, err := client.UpdateARecord(record.Ref, ARecord{ExtensibleAttributesAdd: &ea}); err != nil {
+		t.Errorf("Error adding extensible attributes: %s", err)
+	}
+	if _, err := client.UpdateARecord(record.Ref, ARecord{ExtensibleAttributesRemove: &ea}); err != nil {
+		t.Errorf("Error removing extensible attributes: %s", err)
+	}
+	batch := NewBatch()
+	batch.Update(record.Ref, &ARecord{ExtensibleAttributesAdd: &ea})
+	if _, err := client.ExecuteBatch(batch); err != nil {
+		t.Errorf("Error adding extensible attributes in batch: %s", err)
+	}
+}
+
+func TestCompareVersions(t *testing.T) {
+	cases := []struct {
+		a, b string
+		want int
+	}{
+		{"2.10", "2.9", 1},
+		{"2.9", "2.10", -1},
+		{"2.11", "2.11", 0},
+		{"2.12.1", "2.12", 1},
+		{"1.0", "2.0", -1},
+	}
+	for _, c := range cases {
+		if got := compareVersions(c.a, c.b); got != c.want {
+			t.Errorf("compareVersions(%s, %s) = %d, expected %d", c.a, c.b, got, c.want)
+		}
+	}
+}
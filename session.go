@@ -0,0 +1,138 @@
+package infoblox
+
+import (
+	"io"
+	"net/http"
+	"sync"
+)
+
+const (
+	authCookieName = "ibapauth"
+)
+
+// session tracks the ibapauth cookie shared by every request of a client
+type session struct {
+	mu     sync.Mutex
+	cookie *http.Cookie
+	// sessionless is set when the grid does not issue session cookies so
+	// requests authenticate individually without serializing on login
+	sessionless bool
+	// login serializes authentication so concurrent requests log in once
+	login sync.Mutex
+}
+
+func (s *session) current() (*http.Cookie, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.cookie, s.sessionless
+}
+
+func (s *session) set(cookie *http.Cookie, sessionless bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.cookie = cookie
+	s.sessionless = sessionless
+}
+
+// invalidate clears the session if it still holds the supplied cookie
+func (s *session) invalidate(cookie *http.Cookie) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.cookie == cookie {
+		s.cookie = nil
+	}
+}
+
+// take clears the session returning the cookie it held
+func (s *session) take() *http.Cookie {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	cookie := s.cookie
+	s.cookie = nil
+	s.sessionless = false
+	return cookie
+}
+
+// cloneRequest copies the request with a fresh body so it can be resent
+func cloneRequest(request *http.Request) (*http.Request, error) {
+	clone := request.Clone(request.Context())
+	if request.GetBody != nil {
+		body, err := request.GetBody()
+		if err != nil {
+			return nil, err
+		}
+		clone.Body = body
+	}
+	return clone, nil
+}
+
+// send issues the request using the session cookie, logging in with basic
+// auth when no session exists and once more when the session has expired
+func (c *Client) send(request *http.Request) (*http.Response, error) {
+	cookie, sessionless := c.session.current()
+	if cookie != nil {
+		attempt, err := cloneRequest(request)
+		if err != nil {
+			return nil, err
+		}
+		attempt.AddCookie(cookie)
+		response, err := c.do(attempt)
+		if err != nil || response.StatusCode != http.StatusUnauthorized {
+			return response, err
+		}
+		io.Copy(io.Discard, response.Body)
+		response.Body.Close()
+		c.session.invalidate(cookie)
+	} else if sessionless {
+		return c.authenticate(request)
+	}
+	return c.login(request)
+}
+
+// login sends the request with basic auth and stores the issued session
+// cookie.  Requests waiting on another login reuse its session
+func (c *Client) login(request *http.Request) (*http.Response, error) {
+	c.session.login.Lock()
+	defer c.session.login.Unlock()
+
+	if cookie, _ := c.session.current(); cookie != nil {
+		attempt, err := cloneRequest(request)
+		if err != nil {
+			return nil, err
+		}
+		attempt.AddCookie(cookie)
+		return c.do(attempt)
+	}
+	response, err := c.authenticate(request)
+	if err != nil {
+		return nil, err
+	}
+	if response.StatusCode == http.StatusUnauthorized {
+		return response, nil
+	}
+	for _, cookie := range response.Cookies() {
+		if cookie.Name == authCookieName {
+			c.session.set(cookie, false)
+			return response, nil
+		}
+	}
+	// Only a successful response proves the grid does not issue session
+	// cookies, errors from the grid or a proxy leave the session state alone
+	if response.StatusCode >= 200 && response.StatusCode <= 299 {
+		c.session.set(nil, true)
+	}
+	return response, nil
+}
+
+// authenticate sends the request with basic auth credentials.  Without a
+// username the grid authenticates the client certificate instead
+func (c *Client) authenticate(request *http.Request) (*http.Response, error) {
+	attempt, err := cloneRequest(request)
+	if err != nil {
+		return nil, err
+	}
+	if c.config.Username != "" {
+		attempt.SetBasicAuth(c.config.Username, c.config.Password)
+	}
+	return c.do(attempt)
+}
@@ -0,0 +1,144 @@
+package infoblox
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/techBeck03/infoblox-go-sdk/infobloxtest"
+)
+
+// newSessionTestClient returns a client for a fake grid requiring
+// credentials along with a count of basic auth logins
+func newSessionTestClient(t *testing.T) (*Client, *infobloxtest.Server, *int32) {
+	t.Helper()
+	fake := infobloxtest.NewServer()
+	fake.Username = "admin"
+	fake.Password = "infoblox"
+	t.Cleanup(fake.Close)
+
+	var logins int32
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if _, _, ok := r.BasicAuth(); ok {
+			atomic.AddInt32(&logins, 1)
+		}
+		fake.ServeHTTP(w, r)
+	}))
+	t.Cleanup(server.Close)
+
+	client := New(Config{
+		Host:     strings.TrimPrefix(server.URL, "https://"),
+		Version:  infobloxtest.Version,
+		Username: fake.Username,
+		Password: fake.Password,
+	})
+	client.client = server.Client()
+	client.baseURL = server.URL + "/wapi/v" + infobloxtest.Version
+	return client, fake, &logins
+}
+
+func TestSessionLogsInOnce(t *testing.T) {
+	client, _, logins := newSessionTestClient(t)
+
+	var wg sync.WaitGroup
+	errs := make(chan error, 10)
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := client.GetGridsByQuery(map[string]string{}); err != nil {
+				errs <- err
+			}
+		}()
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		t.Errorf("Error retrieving grids: %s", err)
+	}
+	if atomic.LoadInt32(logins) != 1 {
+		t.Errorf("Expected a single login, got %d", atomic.LoadInt32(logins))
+	}
+}
+
+func TestSessionReauthenticatesOnExpiry(t *testing.T) {
+	client, fake, logins := newSessionTestClient(t)
+
+	if _, err := client.GetGridsByQuery(map[string]string{}); err != nil {
+		t.Fatalf("Error retrieving grids: %s", err)
+	}
+	fake.ExpireSessions()
+	if _, err := client.GetGridsByQuery(map[string]string{}); err != nil {
+		t.Fatalf("Error retrieving grids after session expiry: %s", err)
+	}
+	if atomic.LoadInt32(logins) != 2 {
+		t.Errorf("Expected a second login after expiry, got %d", atomic.LoadInt32(logins))
+	}
+}
+
+func TestSessionLoginAfterErrorResponse(t *testing.T) {
+	client, fake, logins := newSessionTestClient(t)
+	var unavailable int32 = 1
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.CompareAndSwapInt32(&unavailable, 1, 0) {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		if _, _, ok := r.BasicAuth(); ok {
+			atomic.AddInt32(logins, 1)
+		}
+		fake.ServeHTTP(w, r)
+	}))
+	t.Cleanup(server.Close)
+	client.client = server.Client()
+	client.baseURL = server.URL + "/wapi/v" + infobloxtest.Version
+
+	if _, err := client.GetGridsByQuery(map[string]string{}); err == nil {
+		t.Fatalf("Expected service unavailable error")
+	}
+	for i := 0; i < 3; i++ {
+		if _, err := client.GetGridsByQuery(map[string]string{}); err != nil {
+			t.Fatalf("Error retrieving grids: %s", err)
+		}
+	}
+	if atomic.LoadInt32(logins) != 1 {
+		t.Errorf("Expected a session to be established after the error response, got %d logins", atomic.LoadInt32(logins))
+	}
+}
+
+func TestSessionInvalidCredentials(t *testing.T) {
+	client, _, _ := newSessionTestClient(t)
+	client.config.Password = "wrong"
+
+	_, err := client.GetGridsByQuery(map[string]string{})
+	if !IsAuthError(err) {
+		t.Errorf("Expected auth error, got %v", err)
+	}
+}
+
+func TestLogout(t *testing.T) {
+	client, fake, logins := newSessionTestClient(t)
+
+	if err := client.Logout(); err != nil {
+		t.Errorf("Expected logout without a session to succeed, got %s", err)
+	}
+	if _, err := client.GetGridsByQuery(map[string]string{}); err != nil {
+		t.Fatalf("Error retrieving grids: %s", err)
+	}
+	if err := client.Logout(); err != nil {
+		t.Fatalf("Error logging out: %s", err)
+	}
+	requests := fake.Requests()
+	if last := requests[len(requests)-1]; !strings.HasPrefix(last, "POST /wapi/v"+infobloxtest.Version+"/logout") {
+		t.Errorf("Expected logout request, got %s", last)
+	}
+	if _, err := client.GetGridsByQuery(map[string]string{}); err != nil {
+		t.Fatalf("Error retrieving grids after logout: %s", err)
+	}
+	if atomic.LoadInt32(logins) != 2 {
+		t.Errorf("Expected a new login after logout, got %d", atomic.LoadInt32(logins))
+	}
+}
@@ -0,0 +1,127 @@
+package infoblox
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+const (
+	srvRecordBasePath     = "record:srv"
+	srvRecordReturnFields = "name,target,priority,weight,port,view,dns_name,dns_target,disable,comment,zone,extattrs"
+)
+
+// GetSRVRecordByRef gets SRV record by reference
+func (c *Client) GetSRVRecordByRef(ref string, queryParams map[string]string) (SRVRecord, error) {
+	return c.GetSRVRecordByRefWithContext(context.Background(), ref, queryParams)
+}
+
+// GetSRVRecordByRefWithContext gets SRV record by reference using the supplied context
+func (c *Client) GetSRVRecordByRefWithContext(ctx context.Context, ref string, queryParams map[string]string) (SRVRecord, error) {
+	var ret SRVRecord
+	queryParams = returnFields(queryParams, srvRecordReturnFields)
+
+	queryParamString := c.BuildQuery(queryParams)
+
+	request, err := c.CreateJSONRequestWithContext(ctx, http.MethodGet, fmt.Sprintf("%s?%s", ref, queryParamString), nil)
+	if err != nil {
+		return ret, err
+	}
+
+	response := c.Call(request, &ret)
+	if response != nil {
+		return ret, response
+	}
+
+	return ret, nil
+}
+
+// GetSRVRecordByQuery gets SRV records by query parameters
+func (c *Client) GetSRVRecordByQuery(queryParams map[string]string) ([]SRVRecord, error) {
+	return c.GetSRVRecordByQueryWithContext(context.Background(), queryParams)
+}
+
+// GetSRVRecordByQueryWithContext gets SRV records by query parameters using the supplied context
+func (c *Client) GetSRVRecordByQueryWithContext(ctx context.Context, queryParams map[string]string) ([]SRVRecord, error) {
+	return c.ListSRVRecordsWithContext(ctx, queryParams)
+}
+
+// ListSRVRecords lists all SRV records matching query parameters following every result page
+func (c *Client) ListSRVRecords(queryParams map[string]string) ([]SRVRecord, error) {
+	return c.ListSRVRecordsWithContext(context.Background(), queryParams)
+}
+
+// ListSRVRecordsWithContext lists all SRV records matching query parameters following every result page using the supplied context
+func (c *Client) ListSRVRecordsWithContext(ctx context.Context, queryParams map[string]string) ([]SRVRecord, error) {
+	return listWithReturnFields[SRVRecord](ctx, c, srvRecordBasePath, srvRecordReturnFields, queryParams)
+}
+
+// CreateSRVRecord creates SRV record
+func (c *Client) CreateSRVRecord(record *SRVRecord) error {
+	return c.CreateSRVRecordWithContext(context.Background(), record)
+}
+
+// CreateSRVRecordWithContext creates SRV record using the supplied context
+func (c *Client) CreateSRVRecordWithContext(ctx context.Context, record *SRVRecord) error {
+	record.View = c.dnsView(record.View)
+	queryParams := map[string]string{
+		"_return_fields": srvRecordReturnFields,
+	}
+	queryParamString := c.BuildQuery(queryParams)
+	request, err := c.CreateJSONRequestWithContext(ctx, http.MethodPost, fmt.Sprintf("%s?%s", srvRecordBasePath, queryParamString), record)
+	if err != nil {
+		return err
+	}
+
+	response := c.Call(request, &record)
+	if response != nil {
+		return response
+	}
+	return nil
+}
+
+// UpdateSRVRecord updates SRV record
+func (c *Client) UpdateSRVRecord(ref string, record SRVRecord) (SRVRecord, error) {
+	return c.UpdateSRVRecordWithContext(context.Background(), ref, record)
+}
+
+// UpdateSRVRecordWithContext updates SRV record using the supplied context
+func (c *Client) UpdateSRVRecordWithContext(ctx context.Context, ref string, record SRVRecord) (SRVRecord, error) {
+	var ret SRVRecord
+	queryParams := map[string]string{
+		"_return_fields": srvRecordReturnFields,
+	}
+	queryParamString := c.BuildQuery(queryParams)
+	request, err := c.CreateJSONRequestWithContext(ctx, http.MethodPut, fmt.Sprintf("%s?%s", ref, queryParamString), record)
+	if err != nil {
+		return ret, err
+	}
+
+	response := c.Call(request, &ret)
+	if response != nil {
+		return ret, response
+	}
+	return ret, nil
+}
+
+// DeleteSRVRecord deletes SRV record
+func (c *Client) DeleteSRVRecord(ref string) error {
+	return c.DeleteSRVRecordWithContext(context.Background(), ref)
+}
+
+// DeleteSRVRecordWithContext deletes SRV record using the supplied context
+func (c *Client) DeleteSRVRecordWithContext(ctx context.Context, ref string) error {
+	request, err := c.CreateJSONRequestWithContext(ctx, http.MethodDelete, fmt.Sprintf("%s", ref), nil)
+	if err != nil {
+		return err
+	}
+
+	response := c.Call(request, nil)
+	if response != nil {
+		if IsNotFound(response) {
+			return nil
+		}
+		return response
+	}
+	return nil
+}
@@ -0,0 +1,171 @@
+package infoblox
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+)
+
+const (
+	tlsaRecordBasePath     = "record:tlsa"
+	tlsaRecordReturnFields = "name,certificate_usage,selector,matched_type,certificate_data,view,dns_name,disable,comment,zone,extattrs"
+)
+
+// GetTLSARecordByRef gets TLSA record by reference
+func (c *Client) GetTLSARecordByRef(ref string, queryParams map[string]string) (TLSARecord, error) {
+	return c.GetTLSARecordByRefWithContext(context.Background(), ref, queryParams)
+}
+
+// GetTLSARecordByRefWithContext gets TLSA record by reference using the supplied context
+func (c *Client) GetTLSARecordByRefWithContext(ctx context.Context, ref string, queryParams map[string]string) (TLSARecord, error) {
+	var ret TLSARecord
+	queryParams = returnFields(queryParams, tlsaRecordReturnFields)
+
+	queryParamString := c.BuildQuery(queryParams)
+
+	request, err := c.CreateJSONRequestWithContext(ctx, http.MethodGet, fmt.Sprintf("%s?%s", ref, queryParamString), nil)
+	if err != nil {
+		return ret, err
+	}
+
+	response := c.Call(request, &ret)
+	if response != nil {
+		return ret, response
+	}
+
+	return ret, nil
+}
+
+// GetTLSARecordByQuery gets TLSA records by query parameters
+func (c *Client) GetTLSARecordByQuery(queryParams map[string]string) ([]TLSARecord, error) {
+	return c.GetTLSARecordByQueryWithContext(context.Background(), queryParams)
+}
+
+// GetTLSARecordByQueryWithContext gets TLSA records by query parameters using the supplied context
+func (c *Client) GetTLSARecordByQueryWithContext(ctx context.Context, queryParams map[string]string) ([]TLSARecord, error) {
+	return c.ListTLSARecordsWithContext(ctx, queryParams)
+}
+
+// ListTLSARecords lists all TLSA records matching query parameters following every result page
+func (c *Client) ListTLSARecords(queryParams map[string]string) ([]TLSARecord, error) {
+	return c.ListTLSARecordsWithContext(context.Background(), queryParams)
+}
+
+// ListTLSARecordsWithContext lists all TLSA records matching query parameters following every result page using the supplied context
+func (c *Client) ListTLSARecordsWithContext(ctx context.Context, queryParams map[string]string) ([]TLSARecord, error) {
+	return listWithReturnFields[TLSARecord](ctx, c, tlsaRecordBasePath, tlsaRecordReturnFields, queryParams)
+}
+
+// CreateTLSARecord creates TLSA record
+func (c *Client) CreateTLSARecord(record *TLSARecord) error {
+	return c.CreateTLSARecordWithContext(context.Background(), record)
+}
+
+// CreateTLSARecordWithContext creates TLSA record using the supplied context
+func (c *Client) CreateTLSARecordWithContext(ctx context.Context, record *TLSARecord) error {
+	record.View = c.dnsView(record.View)
+	if err := record.Validate(); err != nil {
+		return err
+	}
+	queryParams := map[string]string{
+		"_return_fields": tlsaRecordReturnFields,
+	}
+	queryParamString := c.BuildQuery(queryParams)
+	request, err := c.CreateJSONRequestWithContext(ctx, http.MethodPost, fmt.Sprintf("%s?%s", tlsaRecordBasePath, queryParamString), record)
+	if err != nil {
+		return err
+	}
+
+	response := c.Call(request, &record)
+	if response != nil {
+		return response
+	}
+	return nil
+}
+
+// UpdateTLSARecord updates TLSA record
+func (c *Client) UpdateTLSARecord(ref string, record TLSARecord) (TLSARecord, error) {
+	return c.UpdateTLSARecordWithContext(context.Background(), ref, record)
+}
+
+// UpdateTLSARecordWithContext updates TLSA record using the supplied context
+func (c *Client) UpdateTLSARecordWithContext(ctx context.Context, ref string, record TLSARecord) (TLSARecord, error) {
+	var ret TLSARecord
+	if err := record.Validate(); err != nil {
+		return ret, err
+	}
+	queryParams := map[string]string{
+		"_return_fields": tlsaRecordReturnFields,
+	}
+	queryParamString := c.BuildQuery(queryParams)
+	request, err := c.CreateJSONRequestWithContext(ctx, http.MethodPut, fmt.Sprintf("%s?%s", ref, queryParamString), record)
+	if err != nil {
+		return ret, err
+	}
+
+	response := c.Call(request, &ret)
+	if response != nil {
+		return ret, response
+	}
+	return ret, nil
+}
+
+// DeleteTLSARecord deletes TLSA record
+func (c *Client) DeleteTLSARecord(ref string) error {
+	return c.DeleteTLSARecordWithContext(context.Background(), ref)
+}
+
+// DeleteTLSARecordWithContext deletes TLSA record using the supplied context
+func (c *Client) DeleteTLSARecordWithContext(ctx context.Context, ref string) error {
+	request, err := c.CreateJSONRequestWithContext(ctx, http.MethodDelete, fmt.Sprintf("%s", ref), nil)
+	if err != nil {
+		return err
+	}
+
+	response := c.Call(request, nil)
+	if response != nil {
+		if IsNotFound(response) {
+			return nil
+		}
+		return response
+	}
+	return nil
+}
+
+// TLSA matched types
+const (
+	TLSAMatchedTypeFull   = 0
+	TLSAMatchedTypeSHA256 = 1
+	TLSAMatchedTypeSHA512 = 2
+)
+
+// Validate checks the TLSA certificate usage, selector, matched type and
+// certificate data formats
+func (r TLSARecord) Validate() error {
+	if err := validateIntRange("TLSA certificate usage", r.CertificateUsage, 0, 3); err != nil {
+		return err
+	}
+	if err := validateIntRange("TLSA selector", r.Selector, 0, 1); err != nil {
+		return err
+	}
+	if err := validateIntRange("TLSA matched type", r.MatchedType, 0, 2); err != nil {
+		return err
+	}
+	if r.CertificateData == "" {
+		return nil
+	}
+	data, err := hex.DecodeString(r.CertificateData)
+	if err != nil {
+		return fmt.Errorf("%w: TLSA certificate data must be hexadecimal", ErrValidation)
+	}
+	if r.MatchedType != nil {
+		switch {
+		case *r.MatchedType == TLSAMatchedTypeSHA256 && len(data) != 32:
+			return fmt.Errorf("%w: TLSA SHA-256 certificate data must be 32 bytes, got %d", ErrValidation, len(data))
+		case *r.MatchedType == TLSAMatchedTypeSHA512 && len(data) != 64:
+			return fmt.Errorf("%w: TLSA SHA-512 certificate data must be 64 bytes, got %d", ErrValidation, len(data))
+		}
+	}
+	return nil
+}
@@ -0,0 +1,100 @@
+package infoblox
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+)
+
+// newHTTPClient builds the HTTP client used to reach the grid from the TLS,
+// proxy, timeout and connection pool options of the configuration
+func newHTTPClient(config Config) (*http.Client, error) {
+	if config.HTTPClient != nil {
+		return config.HTTPClient, nil
+	}
+	if config.Transport != nil {
+		return &http.Client{Transport: config.Transport, Timeout: config.Timeout}, nil
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	tlsConfig, err := newTLSConfig(config)
+	if err != nil {
+		return nil, err
+	}
+	transport.TLSClientConfig = tlsConfig
+	if config.ProxyURL != "" {
+		proxy, err := url.Parse(config.ProxyURL)
+		if err != nil {
+			return nil, fmt.Errorf("invalid proxy url %s: %w", config.ProxyURL, err)
+		}
+		if proxy.Scheme != "http" && proxy.Scheme != "https" {
+			return nil, fmt.Errorf("invalid proxy url %s: scheme must be http or https", config.ProxyURL)
+		}
+		transport.Proxy = http.ProxyURL(proxy)
+	}
+	if config.MaxIdleConns > 0 {
+		transport.MaxIdleConns = config.MaxIdleConns
+	}
+	if config.MaxIdleConnsPerHost > 0 {
+		transport.MaxIdleConnsPerHost = config.MaxIdleConnsPerHost
+	}
+	if config.IdleConnTimeout > 0 {
+		transport.IdleConnTimeout = config.IdleConnTimeout
+	}
+	return &http.Client{Transport: transport, Timeout: config.Timeout}, nil
+}
+
+// newTLSConfig loads the certificate authorities and client certificate of
+// the configuration
+func newTLSConfig(config Config) (*tls.Config, error) {
+	tlsConfig := &tls.Config{
+		InsecureSkipVerify: config.DisableTLSVerification,
+		MinVersion:         config.MinTLSVersion,
+	}
+
+	caCert := config.CACert
+	if config.CACertFile != "" {
+		data, err := os.ReadFile(config.CACertFile)
+		if err != nil {
+			return nil, fmt.Errorf("error reading ca certificate: %w", err)
+		}
+		caCert = append(append([]byte{}, caCert...), data...)
+	}
+	if len(caCert) > 0 {
+		pool, err := x509.SystemCertPool()
+		if err != nil || pool == nil {
+			pool = x509.NewCertPool()
+		}
+		if !pool.AppendCertsFromPEM(caCert) {
+			return nil, fmt.Errorf("no valid PEM certificates found in ca certificate")
+		}
+		tlsConfig.RootCAs = pool
+	}
+
+	clientCert, clientKey := config.ClientCert, config.ClientKey
+	if config.ClientCertFile != "" {
+		data, err := os.ReadFile(config.ClientCertFile)
+		if err != nil {
+			return nil, fmt.Errorf("error reading client certificate: %w", err)
+		}
+		clientCert = data
+	}
+	if config.ClientKeyFile != "" {
+		data, err := os.ReadFile(config.ClientKeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("error reading client key: %w", err)
+		}
+		clientKey = data
+	}
+	if len(clientCert) > 0 || len(clientKey) > 0 {
+		certificate, err := tls.X509KeyPair(clientCert, clientKey)
+		if err != nil {
+			return nil, fmt.Errorf("error loading client certificate: %w", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{certificate}
+	}
+	return tlsConfig, nil
+}
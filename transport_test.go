@@ -0,0 +1,214 @@
+package infoblox
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/techBeck03/infoblox-go-sdk/infobloxtest"
+)
+
+// newTransportTestConfig returns the configuration of a client for server
+// without TLS options
+func newTransportTestConfig(server *httptest.Server) Config {
+	address, _ := url.Parse(server.URL)
+	return Config{
+		Host:    address.Hostname(),
+		Port:    address.Port(),
+		Version: infobloxtest.Version,
+	}
+}
+
+// newTestCertificate creates a certificate signed by parent, or a self
+// signed certificate authority when parent is nil, and returns it PEM encoded
+func newTestCertificate(t *testing.T, parent *tls.Certificate, client bool) (tls.Certificate, []byte, []byte) {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("Error generating key: %s", err)
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject:      pkix.Name{CommonName: "infoblox-go-sdk test"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+	}
+	signer, signerKey := template, interface{}(key)
+	if parent == nil {
+		template.IsCA = true
+		template.BasicConstraintsValid = true
+	} else {
+		signer, signerKey = parent.Leaf, parent.PrivateKey
+	}
+	if client {
+		template.ExtKeyUsage = []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth}
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, signer, &key.PublicKey, signerKey)
+	if err != nil {
+		t.Fatalf("Error creating certificate: %s", err)
+	}
+	keyDer, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatalf("Error encoding key: %s", err)
+	}
+	certPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+	keyPEM := pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDer})
+	certificate, err := tls.X509KeyPair(certPEM, keyPEM)
+	if err != nil {
+		t.Fatalf("Error loading certificate: %s", err)
+	}
+	certificate.Leaf, _ = x509.ParseCertificate(der)
+	return certificate, certPEM, keyPEM
+}
+
+func TestTLSCustomCA(t *testing.T) {
+	fake := infobloxtest.NewServer()
+	t.Cleanup(fake.Close)
+	caCert := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: fake.Certificate().Raw})
+
+	config := newTransportTestConfig(fake.Server)
+	config.MinTLSVersion = tls.VersionTLS12
+	if _, err := New(config).GetGridsByQuery(nil); err == nil {
+		t.Errorf("Expected untrusted certificate error")
+	}
+
+	config.CACert = caCert
+	client, err := NewClient(config)
+	if err != nil {
+		t.Fatalf("Error creating client: %s", err)
+	}
+	if _, err := client.GetGridsByQuery(nil); err != nil {
+		t.Errorf("Error retrieving grids with custom CA: %s", err)
+	}
+
+	caFile := filepath.Join(t.TempDir(), "ca.pem")
+	if err := os.WriteFile(caFile, caCert, 0600); err != nil {
+		t.Fatalf("Error writing ca file: %s", err)
+	}
+	config.CACert = nil
+	config.CACertFile = caFile
+	if _, err := New(config).GetGridsByQuery(nil); err != nil {
+		t.Errorf("Error retrieving grids with custom CA file: %s", err)
+	}
+
+	config.CACertFile = ""
+	config.CACert = []byte("not a certificate")
+	client, err = NewClient(config)
+	if err == nil {
+		t.Fatalf("Expected invalid CA error")
+	}
+	if _, err := client.GetGridsByQuery(nil); err == nil || !strings.Contains(err.Error(), "ca certificate") {
+		t.Errorf("Expected requests to report the invalid CA, got %v", err)
+	}
+}
+
+func TestTLSClientCertificate(t *testing.T) {
+	fake := infobloxtest.NewServer()
+	fake.Username = "admin"
+	fake.Password = "infoblox"
+	t.Cleanup(fake.Close)
+
+	ca, caPEM, _ := newTestCertificate(t, nil, false)
+	_, certPEM, keyPEM := newTestCertificate(t, &ca, true)
+	clientCAs := x509.NewCertPool()
+	clientCAs.AppendCertsFromPEM(caPEM)
+
+	server := httptest.NewUnstartedServer(fake)
+	server.TLS = &tls.Config{ClientAuth: tls.RequireAndVerifyClientCert, ClientCAs: clientCAs}
+	server.StartTLS()
+	t.Cleanup(server.Close)
+
+	config := newTransportTestConfig(server)
+	config.DisableTLSVerification = true
+	if _, err := New(config).GetGridsByQuery(nil); err == nil {
+		t.Errorf("Expected error without client certificate")
+	}
+
+	dir := t.TempDir()
+	config.ClientCertFile = filepath.Join(dir, "client.pem")
+	config.ClientKeyFile = filepath.Join(dir, "client.key")
+	os.WriteFile(config.ClientCertFile, certPEM, 0600)
+	os.WriteFile(config.ClientKeyFile, keyPEM, 0600)
+	client, err := NewClient(config)
+	if err != nil {
+		t.Fatalf("Error creating client: %s", err)
+	}
+	if _, err := client.GetGridsByQuery(nil); err != nil {
+		t.Errorf("Error retrieving grids with client certificate: %s", err)
+	}
+
+	config.ClientKeyFile = ""
+	if _, err := NewClient(config); err == nil {
+		t.Errorf("Expected error for client certificate without key")
+	}
+}
+
+type countingTransport struct {
+	next     http.RoundTripper
+	requests int
+}
+
+func (c *countingTransport) RoundTrip(request *http.Request) (*http.Response, error) {
+	c.requests++
+	return c.next.RoundTrip(request)
+}
+
+func TestHTTPClientOptions(t *testing.T) {
+	config := Config{
+		ProxyURL:            "http://proxy.example.com:3128",
+		Timeout:             30 * time.Second,
+		MaxIdleConns:        20,
+		MaxIdleConnsPerHost: 10,
+		IdleConnTimeout:     time.Minute,
+	}
+	client, err := newHTTPClient(config)
+	if err != nil {
+		t.Fatalf("Error creating http client: %s", err)
+	}
+	transport := client.Transport.(*http.Transport)
+	request, _ := http.NewRequest(http.MethodGet, "https://grid.example.com/wapi/v2.11/grid", nil)
+	proxy, _ := transport.Proxy(request)
+	if proxy == nil || proxy.Host != "proxy.example.com:3128" {
+		t.Errorf("Expected proxy.example.com:3128, got %v", proxy)
+	}
+	if client.Timeout != 30*time.Second || transport.MaxIdleConns != 20 || transport.MaxIdleConnsPerHost != 10 || transport.IdleConnTimeout != time.Minute {
+		t.Errorf("Expected timeout and pool options to be applied")
+	}
+
+	config.ProxyURL = "socks4://proxy.example.com"
+	if _, err := newHTTPClient(config); err == nil {
+		t.Errorf("Expected invalid proxy scheme error")
+	}
+
+	fake := infobloxtest.NewServer()
+	t.Cleanup(fake.Close)
+	counter := &countingTransport{next: fake.Client().Transport}
+	config = newTransportTestConfig(fake.Server)
+	config.Transport = counter
+	if _, err := New(config).GetGridsByQuery(nil); err != nil {
+		t.Errorf("Error retrieving grids with custom transport: %s", err)
+	}
+	if counter.requests != 1 {
+		t.Errorf("Expected 1 request through the custom transport, got %d", counter.requests)
+	}
+
+	config.Transport = nil
+	config.HTTPClient = fake.Client()
+	if _, err := New(config).GetGridsByQuery(nil); err != nil {
+		t.Errorf("Error retrieving grids with custom http client: %s", err)
+	}
+}
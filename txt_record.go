@@ -0,0 +1,209 @@
+package infoblox
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"unicode/utf8"
+)
+
+const (
+	txtStringMaxLength    = 255
+	txtRecordBasePath     = "record:txt"
+	txtRecordReturnFields = "text,name,view,dns_name,disable,comment,zone,extattrs"
+)
+
+// GetTXTRecordByRef gets TXT record by reference
+func (c *Client) GetTXTRecordByRef(ref string, queryParams map[string]string) (TXTRecord, error) {
+	return c.GetTXTRecordByRefWithContext(context.Background(), ref, queryParams)
+}
+
+// GetTXTRecordByRefWithContext gets TXT record by reference using the supplied context
+func (c *Client) GetTXTRecordByRefWithContext(ctx context.Context, ref string, queryParams map[string]string) (TXTRecord, error) {
+	var ret TXTRecord
+	queryParams = returnFields(queryParams, txtRecordReturnFields)
+
+	queryParamString := c.BuildQuery(queryParams)
+
+	request, err := c.CreateJSONRequestWithContext(ctx, http.MethodGet, fmt.Sprintf("%s?%s", ref, queryParamString), nil)
+	if err != nil {
+		return ret, err
+	}
+
+	response := c.Call(request, &ret)
+	if response != nil {
+		return ret, response
+	}
+
+	return ret, nil
+}
+
+// GetTXTRecordByQuery gets TXT records by query parameters
+func (c *Client) GetTXTRecordByQuery(queryParams map[string]string) ([]TXTRecord, error) {
+	return c.GetTXTRecordByQueryWithContext(context.Background(), queryParams)
+}
+
+// GetTXTRecordByQueryWithContext gets TXT records by query parameters using the supplied context
+func (c *Client) GetTXTRecordByQueryWithContext(ctx context.Context, queryParams map[string]string) ([]TXTRecord, error) {
+	return c.ListTXTRecordsWithContext(ctx, queryParams)
+}
+
+// ListTXTRecords lists all TXT records matching query parameters following every result page
+func (c *Client) ListTXTRecords(queryParams map[string]string) ([]TXTRecord, error) {
+	return c.ListTXTRecordsWithContext(context.Background(), queryParams)
+}
+
+// ListTXTRecordsWithContext lists all TXT records matching query parameters following every result page using the supplied context
+func (c *Client) ListTXTRecordsWithContext(ctx context.Context, queryParams map[string]string) ([]TXTRecord, error) {
+	return listWithReturnFields[TXTRecord](ctx, c, txtRecordBasePath, txtRecordReturnFields, queryParams)
+}
+
+// CreateTXTRecord creates TXT record, splitting text longer than 255 bytes
+func (c *Client) CreateTXTRecord(record *TXTRecord) error {
+	return c.CreateTXTRecordWithContext(context.Background(), record)
+}
+
+// CreateTXTRecordWithContext creates TXT record, splitting text longer than 255 bytes using the supplied context
+func (c *Client) CreateTXTRecordWithContext(ctx context.Context, record *TXTRecord) error {
+	record.View = c.dnsView(record.View)
+	record.Text = QuoteTXT(record.Text)
+	queryParams := map[string]string{
+		"_return_fields": txtRecordReturnFields,
+	}
+	queryParamString := c.BuildQuery(queryParams)
+	request, err := c.CreateJSONRequestWithContext(ctx, http.MethodPost, fmt.Sprintf("%s?%s", txtRecordBasePath, queryParamString), record)
+	if err != nil {
+		return err
+	}
+
+	response := c.Call(request, &record)
+	if response != nil {
+		return response
+	}
+	return nil
+}
+
+// UpdateTXTRecord updates TXT record, splitting text longer than 255 bytes
+func (c *Client) UpdateTXTRecord(ref string, record TXTRecord) (TXTRecord, error) {
+	return c.UpdateTXTRecordWithContext(context.Background(), ref, record)
+}
+
+// UpdateTXTRecordWithContext updates TXT record, splitting text longer than 255 bytes using the supplied context
+func (c *Client) UpdateTXTRecordWithContext(ctx context.Context, ref string, record TXTRecord) (TXTRecord, error) {
+	var ret TXTRecord
+	record.Text = QuoteTXT(record.Text)
+	queryParams := map[string]string{
+		"_return_fields": txtRecordReturnFields,
+	}
+	queryParamString := c.BuildQuery(queryParams)
+	request, err := c.CreateJSONRequestWithContext(ctx, http.MethodPut, fmt.Sprintf("%s?%s", ref, queryParamString), record)
+	if err != nil {
+		return ret, err
+	}
+
+	response := c.Call(request, &ret)
+	if response != nil {
+		return ret, response
+	}
+	return ret, nil
+}
+
+// DeleteTXTRecord deletes TXT record
+func (c *Client) DeleteTXTRecord(ref string) error {
+	return c.DeleteTXTRecordWithContext(context.Background(), ref)
+}
+
+// DeleteTXTRecordWithContext deletes TXT record using the supplied context
+func (c *Client) DeleteTXTRecordWithContext(ctx context.Context, ref string) error {
+	request, err := c.CreateJSONRequestWithContext(ctx, http.MethodDelete, fmt.Sprintf("%s", ref), nil)
+	if err != nil {
+		return err
+	}
+
+	response := c.Call(request, nil)
+	if response != nil {
+		if IsNotFound(response) {
+			return nil
+		}
+		return response
+	}
+	return nil
+}
+
+// QuoteTXT quotes text as TXT character strings of at most 255 bytes,
+// escaping quotes and backslashes.  Text that already is a sequence of quoted
+// character strings is returned unchanged
+func QuoteTXT(text string) string {
+	if text == "" {
+		return text
+	}
+	if _, ok := parseTXTStrings(text); ok {
+		return text
+	}
+	escaper := strings.NewReplacer(`\`, `\\`, `"`, `\"`)
+	var chunks []string
+	for len(text) > 0 {
+		size := len(text)
+		if size > txtStringMaxLength {
+			size = txtStringMaxLength
+			for size > 0 && !utf8.RuneStart(text[size]) {
+				size--
+			}
+		}
+		chunks = append(chunks, `"`+escaper.Replace(text[:size])+`"`)
+		text = text[size:]
+	}
+	return strings.Join(chunks, " ")
+}
+
+// UnquoteTXT joins the quoted character strings of TXT record text into the
+// original value.  Text that is not a sequence of quoted character strings is
+// returned unchanged
+func UnquoteTXT(text string) string {
+	chunks, ok := parseTXTStrings(text)
+	if !ok {
+		return text
+	}
+	return strings.Join(chunks, "")
+}
+
+// parseTXTStrings parses text made only of whitespace separated quoted
+// character strings of at most 255 bytes and returns their unescaped values
+func parseTXTStrings(text string) ([]string, bool) {
+	var chunks []string
+	for {
+		text = strings.TrimLeft(text, " \t")
+		if text == "" {
+			return chunks, len(chunks) > 0
+		}
+		if text[0] != '"' {
+			return nil, false
+		}
+		var chunk strings.Builder
+		closed := false
+		i := 1
+		for ; i < len(text) && !closed; i++ {
+			switch text[i] {
+			case '\\':
+				if i+1 == len(text) {
+					return nil, false
+				}
+				i++
+				chunk.WriteByte(text[i])
+			case '"':
+				closed = true
+			default:
+				chunk.WriteByte(text[i])
+			}
+		}
+		if !closed || chunk.Len() > txtStringMaxLength {
+			return nil, false
+		}
+		if i < len(text) && text[i] != ' ' && text[i] != '\t' {
+			return nil, false
+		}
+		chunks = append(chunks, chunk.String())
+		text = text[i:]
+	}
+}
@@ -25,9 +25,76 @@ type GridMember struct {
 
 // GridServiceRestartRequest defines properties for grid restart request
 type GridServiceRestartRequest struct {
-	RestartOption string   `json:"restart_option,omitempty"`
-	Services      []string `json:"services,omitempty"`
-	Members       []string `json:"members,omitempty"`
+	RestartOption   string   `json:"restart_option,omitempty"`
+	Services        []string `json:"services,omitempty"`
+	Members         []string `json:"members,omitempty"`
+	Groups          []string `json:"groups,omitempty"`
+	Mode            string   `json:"mode,omitempty"`
+	SequentialDelay *int     `json:"sequential_delay,omitempty"`
+}
+
+// GridServiceRestartStatus defines grid wide service restart progress
+type GridServiceRestartStatus struct {
+	Ref            string `json:"_ref,omitempty"`
+	Parent         string `json:"parent,omitempty"`
+	Grouped        string `json:"grouped,omitempty"`
+	Failures       int    `json:"failures"`
+	Finished       int    `json:"finished"`
+	NeededRestart  int    `json:"needed_restart"`
+	NoRestart      int    `json:"no_restart"`
+	Pending        int    `json:"pending"`
+	PendingRestart int    `json:"pending_restart"`
+	Processing     int    `json:"processing"`
+	Restarting     int    `json:"restarting"`
+	Success        int    `json:"success"`
+	Timeouts       int    `json:"timeouts"`
+}
+
+// MemberServiceRestartStatus defines service restart status of a grid member
+type MemberServiceRestartStatus struct {
+	Ref             string `json:"_ref,omitempty"`
+	Member          string `json:"member,omitempty"`
+	DHCPStatus      string `json:"dhcp_status,omitempty"`
+	DNSStatus       string `json:"dns_status,omitempty"`
+	ReportingStatus string `json:"reporting_status,omitempty"`
+}
+
+// RestartStatus defines grid and member service restart status
+type RestartStatus struct {
+	Grid    GridServiceRestartStatus
+	Members []MemberServiceRestartStatus
+}
+
+// ServiceRestartRequest defines a pending service restart of a grid member
+type ServiceRestartRequest struct {
+	Ref             string `json:"_ref,omitempty"`
+	Member          string `json:"member,omitempty"`
+	Service         string `json:"service,omitempty"`
+	Needed          string `json:"needed,omitempty"`
+	State           string `json:"state,omitempty"`
+	Result          string `json:"result,omitempty"`
+	Error           string `json:"error,omitempty"`
+	Group           string `json:"group,omitempty"`
+	Order           int    `json:"order,omitempty"`
+	Forced          bool   `json:"forced,omitempty"`
+	LastUpdatedTime int64  `json:"last_updated_time,omitempty"`
+}
+
+// ChangedObject defines an object change awaiting a service restart
+type ChangedObject struct {
+	Ref               string   `json:"_ref,omitempty"`
+	Action            string   `json:"action,omitempty"`
+	ObjectName        string   `json:"object_name,omitempty"`
+	ObjectType        string   `json:"object_type,omitempty"`
+	UserName          string   `json:"user_name,omitempty"`
+	ChangedProperties []string `json:"changed_properties,omitempty"`
+	ChangedTime       int64    `json:"changed_time,omitempty"`
+}
+
+// PendingChanges defines changed objects and the member services awaiting a restart
+type PendingChanges struct {
+	Requests       []ServiceRestartRequest
+	ChangedObjects []ChangedObject
 }
 
 // ExtensibleAttribute extensible attribute object
@@ -127,6 +194,66 @@ type NetworkQueryResult struct {
 	Results    []Network `json:"result,omitempty"`
 }
 
+// IPv6Network object
+type IPv6Network struct {
+	Ref                        string               `json:"_ref,omitempty"`
+	NetworkView                string               `json:"network_view,omitempty"`
+	CIDR                       string               `json:"network,omitempty"`
+	Comment                    string               `json:"comment,omitempty"`
+	DisableDHCP                *bool                `json:"disable,omitempty"`
+	Members                    []Member             `json:"members,omitempty"`
+	Options                    []Option             `json:"options,omitempty"`
+	UseOptions                 *bool                `json:"use_options,omitempty"`
+	DomainName                 string               `json:"domain_name,omitempty"`
+	UseDomainName              *bool                `json:"use_domain_name,omitempty"`
+	DomainNameServers          []string             `json:"domain_name_servers,omitempty"`
+	UseDomainNameServers       *bool                `json:"use_domain_name_servers,omitempty"`
+	PreferredLifetime          *int                 `json:"preferred_lifetime,omitempty"`
+	UsePreferredLifetime       *bool                `json:"use_preferred_lifetime,omitempty"`
+	ValidLifetime              *int                 `json:"valid_lifetime,omitempty"`
+	UseValidLifetime           *bool                `json:"use_valid_lifetime,omitempty"`
+	ExtensibleAttributes       *ExtensibleAttribute `json:"extattrs,omitempty"`
+	ExtensibleAttributesAdd    *ExtensibleAttribute `json:"extattrs+,omitempty"`
+	ExtensibleAttributesRemove *ExtensibleAttribute `json:"extattrs-,omitempty"`
+}
+
+// IPv6NetworkContainer object
+type IPv6NetworkContainer struct {
+	Ref                        string               `json:"_ref,omitempty"`
+	NetworkView                string               `json:"network_view,omitempty"`
+	CIDR                       string               `json:"network,omitempty"`
+	Comment                    string               `json:"comment,omitempty"`
+	Options                    []Option             `json:"options,omitempty"`
+	UseOptions                 *bool                `json:"use_options,omitempty"`
+	PreferredLifetime          *int                 `json:"preferred_lifetime,omitempty"`
+	UsePreferredLifetime       *bool                `json:"use_preferred_lifetime,omitempty"`
+	ValidLifetime              *int                 `json:"valid_lifetime,omitempty"`
+	UseValidLifetime           *bool                `json:"use_valid_lifetime,omitempty"`
+	ExtensibleAttributes       *ExtensibleAttribute `json:"extattrs,omitempty"`
+	ExtensibleAttributesAdd    *ExtensibleAttribute `json:"extattrs+,omitempty"`
+	ExtensibleAttributesRemove *ExtensibleAttribute `json:"extattrs-,omitempty"`
+}
+
+// IPv6NetworkFromContainer object
+type IPv6NetworkFromContainer struct {
+	Ref                        string                   `json:"_ref,omitempty"`
+	NetworkView                string                   `json:"network_view,omitempty"`
+	Network                    NetworkContainerFunction `json:"network,omitempty"`
+	Comment                    string                   `json:"comment,omitempty"`
+	DisableDHCP                *bool                    `json:"disable,omitempty"`
+	Members                    []Member                 `json:"members,omitempty"`
+	Options                    []Option                 `json:"options,omitempty"`
+	ExtensibleAttributes       *ExtensibleAttribute     `json:"extattrs,omitempty"`
+	ExtensibleAttributesAdd    *ExtensibleAttribute     `json:"extattrs+,omitempty"`
+	ExtensibleAttributesRemove *ExtensibleAttribute     `json:"extattrs-,omitempty"`
+}
+
+// IPv6NetworkQueryResult object
+type IPv6NetworkQueryResult struct {
+	NextPageID string        `json:"next_page_id,omitempty"`
+	Results    []IPv6Network `json:"result,omitempty"`
+}
+
 // Member defines grid members
 type Member struct {
 	StructType  string `json:"_struct,omitempty"`
@@ -172,10 +299,16 @@ type HostRecord struct {
 	Comment                    string               `json:"comment,omitempty"`
 	EnableDNS                  *bool                `json:"configure_for_dns,omitempty"`
 	IPv4Addrs                  []IPv4Addr           `json:"ipv4addrs,omitempty"`
+	IPv6Addrs                  []IPv6Addr           `json:"ipv6addrs,omitempty"`
 	NetworkView                string               `json:"network_view,omitempty"`
 	RestartIfNeeded            *bool                `json:"restart_if_needed,omitempty"`
 	View                       string               `json:"view,omitempty"`
 	Zone                       string               `json:"zone,omitempty"`
+	TTL                        *int                 `json:"ttl,omitempty"`
+	UseTTL                     *bool                `json:"use_ttl,omitempty"`
+	CreationTime               int64                `json:"creation_time,omitempty"`
+	LastQueried                int64                `json:"last_queried,omitempty"`
+	DDNSProtected              *bool                `json:"ddns_protected,omitempty"`
 	ExtensibleAttributes       *ExtensibleAttribute `json:"extattrs,omitempty"`
 	ExtensibleAttributesAdd    *ExtensibleAttribute `json:"extattrs+,omitempty"`
 	ExtensibleAttributesRemove *ExtensibleAttribute `json:"extattrs-,omitempty"`
@@ -204,6 +337,23 @@ type IPv4Addr struct {
 	ObjectParameters    map[string]interface{} `json:"_object_parameters,omitempty"`
 }
 
+// IPv6Addr object
+type IPv6Addr struct {
+	Ref              string                 `json:"_ref,omitempty"`
+	Host             string                 `json:"host,omitempty"`
+	IPAddress        string                 `json:"ipv6addr,omitempty"`
+	DUID             string                 `json:"duid,omitempty"`
+	AddressType      string                 `json:"address_type,omitempty"`
+	IPv6Prefix       string                 `json:"ipv6prefix,omitempty"`
+	IPv6PrefixBits   *int                   `json:"ipv6prefix_bits,omitempty"`
+	ConfigureForDHCP *bool                  `json:"configure_for_dhcp,omitempty"`
+	ObjectFunction   string                 `json:"_object_function,omitempty"`
+	Parameters       map[string]interface{} `json:"_parameters,omitempty"`
+	ResultField      string                 `json:"_result_field,omitempty"`
+	Object           string                 `json:"_object,omitempty"`
+	ObjectParameters map[string]interface{} `json:"_object_parameters,omitempty"`
+}
+
 // FixedAddress object
 type FixedAddress struct {
 	Ref                        string               `json:"_ref,omitempty"`
@@ -258,7 +408,21 @@ type AddressQuery struct {
 	EndAddress           string
 }
 
-func (aq *AddressQuery) fillDefaults() {
+// NextAvailableIP selects the network or range an address is allocated from.
+// Object is the WAPI object type searched with ObjectParameters, or Ref
+// references the network or range directly.  Exclude lists addresses that
+// must not be allocated
+type NextAvailableIP struct {
+	Object           string
+	ObjectParameters map[string]string
+	Ref              string
+	Exclude          []string
+}
+
+func (aq *AddressQuery) fillDefaults(networkView string) {
+	if aq.NetworkView == "" {
+		aq.NetworkView = networkView
+	}
 	if aq.NetworkView == "" {
 		aq.NetworkView = "default"
 	}
@@ -293,6 +457,78 @@ type RangeQueryResult struct {
 	Results    []Range `json:"result,omitempty"`
 }
 
+// IPv6Range object
+type IPv6Range struct {
+	Ref                        string               `json:"_ref,omitempty"`
+	Name                       string               `json:"name,omitempty"`
+	Comment                    string               `json:"comment,omitempty"`
+	DisableDHCP                *bool                `json:"disable,omitempty"`
+	AddressType                string               `json:"address_type,omitempty"`
+	StartAddress               string               `json:"start_addr,omitempty"`
+	EndAddress                 string               `json:"end_addr,omitempty"`
+	IPv6PrefixBits             *int                 `json:"ipv6_prefix_bits,omitempty"`
+	IPv6StartPrefix            string               `json:"ipv6_start_prefix,omitempty"`
+	IPv6EndPrefix              string               `json:"ipv6_end_prefix,omitempty"`
+	NetworkView                string               `json:"network_view,omitempty"`
+	CIDR                       string               `json:"network,omitempty"`
+	Member                     *Member              `json:"member,omitempty"`
+	ServerAssociationType      string               `json:"server_association_type,omitempty"`
+	ExtensibleAttributes       *ExtensibleAttribute `json:"extattrs,omitempty"`
+	ExtensibleAttributesAdd    *ExtensibleAttribute `json:"extattrs+,omitempty"`
+	ExtensibleAttributesRemove *ExtensibleAttribute `json:"extattrs-,omitempty"`
+}
+
+// IPv6RangeQueryResult object
+type IPv6RangeQueryResult struct {
+	NextPageID string      `json:"next_page_id,omitempty"`
+	Results    []IPv6Range `json:"result,omitempty"`
+}
+
+// IPv6FixedAddress object
+type IPv6FixedAddress struct {
+	Ref                        string               `json:"_ref,omitempty"`
+	NetworkView                string               `json:"network_view,omitempty"`
+	CIDR                       string               `json:"network,omitempty"`
+	Comment                    string               `json:"comment,omitempty"`
+	Disable                    *bool                `json:"disable,omitempty"`
+	IPAddress                  string               `json:"ipv6addr,omitempty"`
+	DUID                       string               `json:"duid,omitempty"`
+	Hostname                   string               `json:"name,omitempty"`
+	AddressType                string               `json:"address_type,omitempty"`
+	IPv6Prefix                 string               `json:"ipv6prefix,omitempty"`
+	IPv6PrefixBits             *int                 `json:"ipv6prefix_bits,omitempty"`
+	Options                    []Option             `json:"options,omitempty"`
+	ExtensibleAttributes       *ExtensibleAttribute `json:"extattrs,omitempty"`
+	ExtensibleAttributesAdd    *ExtensibleAttribute `json:"extattrs+,omitempty"`
+	ExtensibleAttributesRemove *ExtensibleAttribute `json:"extattrs-,omitempty"`
+}
+
+// IPv6FixedAddressQueryResult object
+type IPv6FixedAddressQueryResult struct {
+	NextPageID string             `json:"next_page_id,omitempty"`
+	Results    []IPv6FixedAddress `json:"result,omitempty"`
+}
+
+// IPv6Address object
+type IPv6Address struct {
+	Ref         string   `json:"_ref,omitempty"`
+	Hostnames   []string `json:"names,omitempty"`
+	IPAddress   string   `json:"ip_address,omitempty"`
+	DUID        string   `json:"duid,omitempty"`
+	NetworkView string   `json:"network_view,omitempty"`
+	CIDR        string   `json:"network,omitempty"`
+	Usage       []string `json:"usage,omitempty"`
+	Types       []string `json:"types,omitempty"`
+	Objects     []string `json:"objects,omitempty"`
+	Status      string   `json:"status,omitempty"`
+}
+
+// IPv6AddressQueryResult object
+type IPv6AddressQueryResult struct {
+	NextPageID string        `json:"next_page_id,omitempty"`
+	Results    []IPv6Address `json:"result,omitempty"`
+}
+
 // IPsWithinRangeQuery object
 type IPsWithinRangeQuery struct {
 	Ref          string
@@ -311,6 +547,11 @@ type ARecord struct {
 	Comment                    string               `json:"comment,omitempty"`
 	Disable                    *bool                `json:"disable,omitempty"`
 	View                       string               `json:"view,omitempty"`
+	TTL                        *int                 `json:"ttl,omitempty"`
+	UseTTL                     *bool                `json:"use_ttl,omitempty"`
+	CreationTime               int64                `json:"creation_time,omitempty"`
+	LastQueried                int64                `json:"last_queried,omitempty"`
+	DDNSProtected              *bool                `json:"ddns_protected,omitempty"`
 	ExtensibleAttributes       *ExtensibleAttribute `json:"extattrs,omitempty"`
 	ExtensibleAttributesAdd    *ExtensibleAttribute `json:"extattrs+,omitempty"`
 	ExtensibleAttributesRemove *ExtensibleAttribute `json:"extattrs-,omitempty"`
@@ -322,6 +563,32 @@ type ARecordQueryResult struct {
 	Results    []ARecord `json:"result,omitempty"`
 }
 
+// AAAARecord object
+type AAAARecord struct {
+	Ref                        string               `json:"_ref,omitempty"`
+	Hostname                   string               `json:"name,omitempty"`
+	DNSName                    string               `json:"dns_name,omitempty"`
+	IPAddress                  string               `json:"ipv6addr,omitempty"`
+	Zone                       string               `json:"zone,omitempty"`
+	Comment                    string               `json:"comment,omitempty"`
+	Disable                    *bool                `json:"disable,omitempty"`
+	View                       string               `json:"view,omitempty"`
+	TTL                        *int                 `json:"ttl,omitempty"`
+	UseTTL                     *bool                `json:"use_ttl,omitempty"`
+	CreationTime               int64                `json:"creation_time,omitempty"`
+	LastQueried                int64                `json:"last_queried,omitempty"`
+	DDNSProtected              *bool                `json:"ddns_protected,omitempty"`
+	ExtensibleAttributes       *ExtensibleAttribute `json:"extattrs,omitempty"`
+	ExtensibleAttributesAdd    *ExtensibleAttribute `json:"extattrs+,omitempty"`
+	ExtensibleAttributesRemove *ExtensibleAttribute `json:"extattrs-,omitempty"`
+}
+
+// AAAARecordQueryResult object
+type AAAARecordQueryResult struct {
+	NextPageID string       `json:"next_page_id,omitempty"`
+	Results    []AAAARecord `json:"result,omitempty"`
+}
+
 // CNameRecord object
 type CNameRecord struct {
 	Ref                        string               `json:"_ref,omitempty"`
@@ -332,6 +599,11 @@ type CNameRecord struct {
 	Comment                    string               `json:"comment,omitempty"`
 	Disable                    *bool                `json:"disable,omitempty"`
 	View                       string               `json:"view,omitempty"`
+	TTL                        *int                 `json:"ttl,omitempty"`
+	UseTTL                     *bool                `json:"use_ttl,omitempty"`
+	CreationTime               int64                `json:"creation_time,omitempty"`
+	LastQueried                int64                `json:"last_queried,omitempty"`
+	DDNSProtected              *bool                `json:"ddns_protected,omitempty"`
 	ExtensibleAttributes       *ExtensibleAttribute `json:"extattrs,omitempty"`
 	ExtensibleAttributesAdd    *ExtensibleAttribute `json:"extattrs+,omitempty"`
 	ExtensibleAttributesRemove *ExtensibleAttribute `json:"extattrs-,omitempty"`
@@ -379,6 +651,11 @@ type PtrRecord struct {
 	Comment                    string               `json:"comment,omitempty"`
 	Disable                    *bool                `json:"disable,omitempty"`
 	View                       string               `json:"view,omitempty"`
+	TTL                        *int                 `json:"ttl,omitempty"`
+	UseTTL                     *bool                `json:"use_ttl,omitempty"`
+	CreationTime               int64                `json:"creation_time,omitempty"`
+	LastQueried                int64                `json:"last_queried,omitempty"`
+	DDNSProtected              *bool                `json:"ddns_protected,omitempty"`
 	ExtensibleAttributes       *ExtensibleAttribute `json:"extattrs,omitempty"`
 	ExtensibleAttributesAdd    *ExtensibleAttribute `json:"extattrs+,omitempty"`
 	ExtensibleAttributesRemove *ExtensibleAttribute `json:"extattrs-,omitempty"`
@@ -390,10 +667,397 @@ type PtrRecordQueryResult struct {
 	Results    []PtrRecord `json:"result,omitempty"`
 }
 
+// MXRecord object
+type MXRecord struct {
+	Ref                        string               `json:"_ref,omitempty"`
+	Name                       string               `json:"name,omitempty"`
+	DNSName                    string               `json:"dns_name,omitempty"`
+	MailExchanger              string               `json:"mail_exchanger,omitempty"`
+	DNSMailExchanger           string               `json:"dns_mail_exchanger,omitempty"`
+	Preference                 *int                 `json:"preference,omitempty"`
+	Zone                       string               `json:"zone,omitempty"`
+	Comment                    string               `json:"comment,omitempty"`
+	Disable                    *bool                `json:"disable,omitempty"`
+	View                       string               `json:"view,omitempty"`
+	TTL                        *int                 `json:"ttl,omitempty"`
+	UseTTL                     *bool                `json:"use_ttl,omitempty"`
+	CreationTime               int64                `json:"creation_time,omitempty"`
+	LastQueried                int64                `json:"last_queried,omitempty"`
+	DDNSProtected              *bool                `json:"ddns_protected,omitempty"`
+	ExtensibleAttributes       *ExtensibleAttribute `json:"extattrs,omitempty"`
+	ExtensibleAttributesAdd    *ExtensibleAttribute `json:"extattrs+,omitempty"`
+	ExtensibleAttributesRemove *ExtensibleAttribute `json:"extattrs-,omitempty"`
+}
+
+// MXRecordQueryResult object
+type MXRecordQueryResult struct {
+	NextPageID string     `json:"next_page_id,omitempty"`
+	Results    []MXRecord `json:"result,omitempty"`
+}
+
+// TXTRecord object.  Text is sent as quoted character strings of at most 255
+// bytes, use UnquoteTXT to recover the original value
+type TXTRecord struct {
+	Ref                        string               `json:"_ref,omitempty"`
+	Name                       string               `json:"name,omitempty"`
+	DNSName                    string               `json:"dns_name,omitempty"`
+	Text                       string               `json:"text,omitempty"`
+	Zone                       string               `json:"zone,omitempty"`
+	Comment                    string               `json:"comment,omitempty"`
+	Disable                    *bool                `json:"disable,omitempty"`
+	View                       string               `json:"view,omitempty"`
+	TTL                        *int                 `json:"ttl,omitempty"`
+	UseTTL                     *bool                `json:"use_ttl,omitempty"`
+	CreationTime               int64                `json:"creation_time,omitempty"`
+	LastQueried                int64                `json:"last_queried,omitempty"`
+	DDNSProtected              *bool                `json:"ddns_protected,omitempty"`
+	ExtensibleAttributes       *ExtensibleAttribute `json:"extattrs,omitempty"`
+	ExtensibleAttributesAdd    *ExtensibleAttribute `json:"extattrs+,omitempty"`
+	ExtensibleAttributesRemove *ExtensibleAttribute `json:"extattrs-,omitempty"`
+}
+
+// TXTRecordQueryResult object
+type TXTRecordQueryResult struct {
+	NextPageID string      `json:"next_page_id,omitempty"`
+	Results    []TXTRecord `json:"result,omitempty"`
+}
+
+// SRVRecord object
+type SRVRecord struct {
+	Ref                        string               `json:"_ref,omitempty"`
+	Name                       string               `json:"name,omitempty"`
+	DNSName                    string               `json:"dns_name,omitempty"`
+	Target                     string               `json:"target,omitempty"`
+	DNSTarget                  string               `json:"dns_target,omitempty"`
+	Priority                   *int                 `json:"priority,omitempty"`
+	Weight                     *int                 `json:"weight,omitempty"`
+	Port                       *int                 `json:"port,omitempty"`
+	Zone                       string               `json:"zone,omitempty"`
+	Comment                    string               `json:"comment,omitempty"`
+	Disable                    *bool                `json:"disable,omitempty"`
+	View                       string               `json:"view,omitempty"`
+	TTL                        *int                 `json:"ttl,omitempty"`
+	UseTTL                     *bool                `json:"use_ttl,omitempty"`
+	CreationTime               int64                `json:"creation_time,omitempty"`
+	LastQueried                int64                `json:"last_queried,omitempty"`
+	DDNSProtected              *bool                `json:"ddns_protected,omitempty"`
+	ExtensibleAttributes       *ExtensibleAttribute `json:"extattrs,omitempty"`
+	ExtensibleAttributesAdd    *ExtensibleAttribute `json:"extattrs+,omitempty"`
+	ExtensibleAttributesRemove *ExtensibleAttribute `json:"extattrs-,omitempty"`
+}
+
+// SRVRecordQueryResult object
+type SRVRecordQueryResult struct {
+	NextPageID string      `json:"next_page_id,omitempty"`
+	Results    []SRVRecord `json:"result,omitempty"`
+}
+
+// NSRecord object.  WAPI does not support comments or extensible
+// attributes on NS records
+type NSRecord struct {
+	Ref              string           `json:"_ref,omitempty"`
+	Name             string           `json:"name,omitempty"`
+	DNSName          string           `json:"dns_name,omitempty"`
+	NameServer       string           `json:"nameserver,omitempty"`
+	Addresses        []ZoneNameServer `json:"addresses,omitempty"`
+	MSDelegationName string           `json:"ms_delegation_name,omitempty"`
+	Creator          string           `json:"creator,omitempty"`
+	Zone             string           `json:"zone,omitempty"`
+	View             string           `json:"view,omitempty"`
+}
+
+// ZoneNameServer object
+type ZoneNameServer struct {
+	Address       string `json:"address,omitempty"`
+	AutoCreatePtr *bool  `json:"auto_create_ptr,omitempty"`
+}
+
+// NSRecordQueryResult object
+type NSRecordQueryResult struct {
+	NextPageID string     `json:"next_page_id,omitempty"`
+	Results    []NSRecord `json:"result,omitempty"`
+}
+
+// CAARecord object
+type CAARecord struct {
+	Ref                        string               `json:"_ref,omitempty"`
+	Name                       string               `json:"name,omitempty"`
+	DNSName                    string               `json:"dns_name,omitempty"`
+	CAFlag                     *int                 `json:"ca_flag,omitempty"`
+	CATag                      string               `json:"ca_tag,omitempty"`
+	CAValue                    string               `json:"ca_value,omitempty"`
+	Zone                       string               `json:"zone,omitempty"`
+	Comment                    string               `json:"comment,omitempty"`
+	Disable                    *bool                `json:"disable,omitempty"`
+	View                       string               `json:"view,omitempty"`
+	ExtensibleAttributes       *ExtensibleAttribute `json:"extattrs,omitempty"`
+	ExtensibleAttributesAdd    *ExtensibleAttribute `json:"extattrs+,omitempty"`
+	ExtensibleAttributesRemove *ExtensibleAttribute `json:"extattrs-,omitempty"`
+}
+
+// CAARecordQueryResult object
+type CAARecordQueryResult struct {
+	NextPageID string      `json:"next_page_id,omitempty"`
+	Results    []CAARecord `json:"result,omitempty"`
+}
+
+// NAPTRRecord object
+type NAPTRRecord struct {
+	Ref                        string               `json:"_ref,omitempty"`
+	Name                       string               `json:"name,omitempty"`
+	DNSName                    string               `json:"dns_name,omitempty"`
+	Order                      *int                 `json:"order,omitempty"`
+	Preference                 *int                 `json:"preference,omitempty"`
+	Flags                      *string              `json:"flags,omitempty"`
+	Services                   string               `json:"services,omitempty"`
+	Regexp                     *string              `json:"regexp,omitempty"`
+	Replacement                string               `json:"replacement,omitempty"`
+	DNSReplacement             string               `json:"dns_replacement,omitempty"`
+	Zone                       string               `json:"zone,omitempty"`
+	Comment                    string               `json:"comment,omitempty"`
+	Disable                    *bool                `json:"disable,omitempty"`
+	View                       string               `json:"view,omitempty"`
+	ExtensibleAttributes       *ExtensibleAttribute `json:"extattrs,omitempty"`
+	ExtensibleAttributesAdd    *ExtensibleAttribute `json:"extattrs+,omitempty"`
+	ExtensibleAttributesRemove *ExtensibleAttribute `json:"extattrs-,omitempty"`
+}
+
+// NAPTRRecordQueryResult object
+type NAPTRRecordQueryResult struct {
+	NextPageID string        `json:"next_page_id,omitempty"`
+	Results    []NAPTRRecord `json:"result,omitempty"`
+}
+
+// DNAMERecord object
+type DNAMERecord struct {
+	Ref                        string               `json:"_ref,omitempty"`
+	Name                       string               `json:"name,omitempty"`
+	DNSName                    string               `json:"dns_name,omitempty"`
+	Target                     string               `json:"target,omitempty"`
+	DNSTarget                  string               `json:"dns_target,omitempty"`
+	Zone                       string               `json:"zone,omitempty"`
+	Comment                    string               `json:"comment,omitempty"`
+	Disable                    *bool                `json:"disable,omitempty"`
+	View                       string               `json:"view,omitempty"`
+	ExtensibleAttributes       *ExtensibleAttribute `json:"extattrs,omitempty"`
+	ExtensibleAttributesAdd    *ExtensibleAttribute `json:"extattrs+,omitempty"`
+	ExtensibleAttributesRemove *ExtensibleAttribute `json:"extattrs-,omitempty"`
+}
+
+// DNAMERecordQueryResult object
+type DNAMERecordQueryResult struct {
+	NextPageID string        `json:"next_page_id,omitempty"`
+	Results    []DNAMERecord `json:"result,omitempty"`
+}
+
+// TLSARecord object
+type TLSARecord struct {
+	Ref                        string               `json:"_ref,omitempty"`
+	Name                       string               `json:"name,omitempty"`
+	DNSName                    string               `json:"dns_name,omitempty"`
+	CertificateUsage           *int                 `json:"certificate_usage,omitempty"`
+	Selector                   *int                 `json:"selector,omitempty"`
+	MatchedType                *int                 `json:"matched_type,omitempty"`
+	CertificateData            string               `json:"certificate_data,omitempty"`
+	Zone                       string               `json:"zone,omitempty"`
+	Comment                    string               `json:"comment,omitempty"`
+	Disable                    *bool                `json:"disable,omitempty"`
+	View                       string               `json:"view,omitempty"`
+	ExtensibleAttributes       *ExtensibleAttribute `json:"extattrs,omitempty"`
+	ExtensibleAttributesAdd    *ExtensibleAttribute `json:"extattrs+,omitempty"`
+	ExtensibleAttributesRemove *ExtensibleAttribute `json:"extattrs-,omitempty"`
+}
+
+// TLSARecordQueryResult object
+type TLSARecordQueryResult struct {
+	NextPageID string       `json:"next_page_id,omitempty"`
+	Results    []TLSARecord `json:"result,omitempty"`
+}
+
+// ZoneAuth object.  SOA timers override the grid defaults when
+// UseGridZoneTimer is true and the serial number is only written when
+// SetSOASerialNumber is true
+type ZoneAuth struct {
+	Ref                        string               `json:"_ref,omitempty"`
+	FQDN                       string               `json:"fqdn,omitempty"`
+	DNSFQDN                    string               `json:"dns_fqdn,omitempty"`
+	DisplayDomain              string               `json:"display_domain,omitempty"`
+	ZoneFormat                 string               `json:"zone_format,omitempty"`
+	Prefix                     string               `json:"prefix,omitempty"`
+	View                       string               `json:"view,omitempty"`
+	Comment                    string               `json:"comment,omitempty"`
+	Disable                    *bool                `json:"disable,omitempty"`
+	Locked                     *bool                `json:"locked,omitempty"`
+	NSGroup                    string               `json:"ns_group,omitempty"`
+	PrimaryType                string               `json:"primary_type,omitempty"`
+	GridPrimary                []Member             `json:"grid_primary,omitempty"`
+	GridSecondaries            []Member             `json:"grid_secondaries,omitempty"`
+	SOADefaultTTL              *int                 `json:"soa_default_ttl,omitempty"`
+	SOAEmail                   string               `json:"soa_email,omitempty"`
+	SOAExpire                  *int                 `json:"soa_expire,omitempty"`
+	SOANegativeTTL             *int                 `json:"soa_negative_ttl,omitempty"`
+	SOARefresh                 *int                 `json:"soa_refresh,omitempty"`
+	SOARetry                   *int                 `json:"soa_retry,omitempty"`
+	SOASerialNumber            *int                 `json:"soa_serial_number,omitempty"`
+	SetSOASerialNumber         *bool                `json:"set_soa_serial_number,omitempty"`
+	UseGridZoneTimer           *bool                `json:"use_grid_zone_timer,omitempty"`
+	ExtensibleAttributes       *ExtensibleAttribute `json:"extattrs,omitempty"`
+	ExtensibleAttributesAdd    *ExtensibleAttribute `json:"extattrs+,omitempty"`
+	ExtensibleAttributesRemove *ExtensibleAttribute `json:"extattrs-,omitempty"`
+}
+
+// ZoneAuthQueryResult object
+type ZoneAuthQueryResult struct {
+	NextPageID string     `json:"next_page_id,omitempty"`
+	Results    []ZoneAuth `json:"result,omitempty"`
+}
+
+// ExternalServer defines a name server outside the grid
+type ExternalServer struct {
+	Address string `json:"address,omitempty"`
+	Name    string `json:"name,omitempty"`
+	Stealth *bool  `json:"stealth,omitempty"`
+}
+
+// ForwardingMemberServer defines a grid member forwarding queries for a forward zone
+type ForwardingMemberServer struct {
+	Name                  string           `json:"name,omitempty"`
+	ForwardersOnly        *bool            `json:"forwarders_only,omitempty"`
+	ForwardTo             []ExternalServer `json:"forward_to,omitempty"`
+	UseOverrideForwarders *bool            `json:"use_override_forwarders,omitempty"`
+}
+
+// ZoneForward object
+type ZoneForward struct {
+	Ref                        string                   `json:"_ref,omitempty"`
+	FQDN                       string                   `json:"fqdn,omitempty"`
+	DNSFQDN                    string                   `json:"dns_fqdn,omitempty"`
+	DisplayDomain              string                   `json:"display_domain,omitempty"`
+	ZoneFormat                 string                   `json:"zone_format,omitempty"`
+	View                       string                   `json:"view,omitempty"`
+	Comment                    string                   `json:"comment,omitempty"`
+	Disable                    *bool                    `json:"disable,omitempty"`
+	Locked                     *bool                    `json:"locked,omitempty"`
+	NSGroup                    string                   `json:"ns_group,omitempty"`
+	ExternalNSGroup            string                   `json:"external_ns_group,omitempty"`
+	ForwardTo                  []ExternalServer         `json:"forward_to,omitempty"`
+	ForwardersOnly             *bool                    `json:"forwarders_only,omitempty"`
+	ForwardingServers          []ForwardingMemberServer `json:"forwarding_servers,omitempty"`
+	ExtensibleAttributes       *ExtensibleAttribute     `json:"extattrs,omitempty"`
+	ExtensibleAttributesAdd    *ExtensibleAttribute     `json:"extattrs+,omitempty"`
+	ExtensibleAttributesRemove *ExtensibleAttribute     `json:"extattrs-,omitempty"`
+}
+
+// ZoneForwardQueryResult object
+type ZoneForwardQueryResult struct {
+	NextPageID string        `json:"next_page_id,omitempty"`
+	Results    []ZoneForward `json:"result,omitempty"`
+}
+
+// ZoneStub object
+type ZoneStub struct {
+	Ref                        string               `json:"_ref,omitempty"`
+	FQDN                       string               `json:"fqdn,omitempty"`
+	DNSFQDN                    string               `json:"dns_fqdn,omitempty"`
+	DisplayDomain              string               `json:"display_domain,omitempty"`
+	ZoneFormat                 string               `json:"zone_format,omitempty"`
+	View                       string               `json:"view,omitempty"`
+	Comment                    string               `json:"comment,omitempty"`
+	Disable                    *bool                `json:"disable,omitempty"`
+	Locked                     *bool                `json:"locked,omitempty"`
+	NSGroup                    string               `json:"ns_group,omitempty"`
+	StubFrom                   []ExternalServer     `json:"stub_from,omitempty"`
+	StubMembers                []Member             `json:"stub_members,omitempty"`
+	ExtensibleAttributes       *ExtensibleAttribute `json:"extattrs,omitempty"`
+	ExtensibleAttributesAdd    *ExtensibleAttribute `json:"extattrs+,omitempty"`
+	ExtensibleAttributesRemove *ExtensibleAttribute `json:"extattrs-,omitempty"`
+}
+
+// ZoneStubQueryResult object
+type ZoneStubQueryResult struct {
+	NextPageID string     `json:"next_page_id,omitempty"`
+	Results    []ZoneStub `json:"result,omitempty"`
+}
+
+// ZoneDelegated object
+type ZoneDelegated struct {
+	Ref                        string               `json:"_ref,omitempty"`
+	FQDN                       string               `json:"fqdn,omitempty"`
+	DNSFQDN                    string               `json:"dns_fqdn,omitempty"`
+	DisplayDomain              string               `json:"display_domain,omitempty"`
+	ZoneFormat                 string               `json:"zone_format,omitempty"`
+	View                       string               `json:"view,omitempty"`
+	Comment                    string               `json:"comment,omitempty"`
+	Disable                    *bool                `json:"disable,omitempty"`
+	Locked                     *bool                `json:"locked,omitempty"`
+	NSGroup                    string               `json:"ns_group,omitempty"`
+	DelegateTo                 []ExternalServer     `json:"delegate_to,omitempty"`
+	DelegatedTTL               *int                 `json:"delegated_ttl,omitempty"`
+	UseDelegatedTTL            *bool                `json:"use_delegated_ttl,omitempty"`
+	ExtensibleAttributes       *ExtensibleAttribute `json:"extattrs,omitempty"`
+	ExtensibleAttributesAdd    *ExtensibleAttribute `json:"extattrs+,omitempty"`
+	ExtensibleAttributesRemove *ExtensibleAttribute `json:"extattrs-,omitempty"`
+}
+
+// ZoneDelegatedQueryResult object
+type ZoneDelegatedQueryResult struct {
+	NextPageID string          `json:"next_page_id,omitempty"`
+	Results    []ZoneDelegated `json:"result,omitempty"`
+}
+
+// DNSView object
+type DNSView struct {
+	Ref                        string               `json:"_ref,omitempty"`
+	Name                       string               `json:"name,omitempty"`
+	Comment                    string               `json:"comment,omitempty"`
+	Disable                    *bool                `json:"disable,omitempty"`
+	NetworkView                string               `json:"network_view,omitempty"`
+	IsDefault                  *bool                `json:"is_default,omitempty"`
+	Recursion                  *bool                `json:"recursion,omitempty"`
+	ExtensibleAttributes       *ExtensibleAttribute `json:"extattrs,omitempty"`
+	ExtensibleAttributesAdd    *ExtensibleAttribute `json:"extattrs+,omitempty"`
+	ExtensibleAttributesRemove *ExtensibleAttribute `json:"extattrs-,omitempty"`
+}
+
+// DNSViewQueryResult object
+type DNSViewQueryResult struct {
+	NextPageID string    `json:"next_page_id,omitempty"`
+	Results    []DNSView `json:"result,omitempty"`
+}
+
+// NetworkView object.  AssociatedDNSViews is read only, associate a DNS
+// view by setting its NetworkView
+type NetworkView struct {
+	Ref                        string               `json:"_ref,omitempty"`
+	Name                       string               `json:"name,omitempty"`
+	Comment                    string               `json:"comment,omitempty"`
+	IsDefault                  *bool                `json:"is_default,omitempty"`
+	AssociatedDNSViews         []string             `json:"associated_dns_views,omitempty"`
+	ExtensibleAttributes       *ExtensibleAttribute `json:"extattrs,omitempty"`
+	ExtensibleAttributesAdd    *ExtensibleAttribute `json:"extattrs+,omitempty"`
+	ExtensibleAttributesRemove *ExtensibleAttribute `json:"extattrs-,omitempty"`
+}
+
+// NetworkViewQueryResult object
+type NetworkViewQueryResult struct {
+	NextPageID string        `json:"next_page_id,omitempty"`
+	Results    []NetworkView `json:"result,omitempty"`
+}
+
 // ResponseError object
 type ResponseError struct {
 	StatusCode   int
 	Request      string
 	ResponseBody string
 	ErrorMessage string
+	WAPIError    *WAPIError
+	Err          error
+}
+
+// WAPIError decoded error body returned by WAPI
+type WAPIError struct {
+	Error string `json:"Error,omitempty"`
+	Code  string `json:"code,omitempty"`
+	Text  string `json:"text,omitempty"`
+	Trace string `json:"trace,omitempty"`
 }
@@ -0,0 +1,183 @@
+//go:build all || unittests
+// +build all unittests
+
+package infoblox
+
+import (
+	"testing"
+)
+
+var (
+	viewConfig      = testConfig()
+	viewClient      = New(viewConfig)
+	testNetworkView = NetworkView{
+		Name:    "test-api-netview",
+		Comment: "Network view testing",
+		ExtensibleAttributes: newExtensibleAttribute(ExtensibleAttribute{
+			"Owner": ExtensibleAttributeValue{
+				Value: "testUser",
+			},
+		}),
+	}
+	testDNSView = DNSView{
+		Name:        "test-api-view",
+		NetworkView: "test-api-netview",
+		Comment:     "DNS view testing",
+	}
+	testViewNetwork = Network{
+		CIDR:    "172.19.210.0/24",
+		Comment: "Default view testing",
+	}
+	testViewDefaultNetwork = Network{
+		CIDR:        "172.19.210.0/24",
+		NetworkView: "default",
+		Comment:     "Default view testing",
+	}
+	testViewARecord = ARecord{
+		Hostname:  "test-api-view.auslab.cisco.com",
+		IPAddress: "172.19.210.10",
+	}
+)
+
+func TestCreateNetworkView(t *testing.T) {
+	err := viewClient.CreateNetworkView(&testNetworkView)
+	if err != nil {
+		t.Errorf("Error creating network view: %s", err)
+	}
+	if testNetworkView.IsDefault == nil || *testNetworkView.IsDefault {
+		t.Errorf("Expected network view not to be the default")
+	}
+}
+
+func TestCreateDNSView(t *testing.T) {
+	err := viewClient.CreateDNSView(&testDNSView)
+	if err != nil {
+		t.Errorf("Error creating DNS view: %s", err)
+	}
+	networkView, err := viewClient.GetNetworkViewByRef(testNetworkView.Ref, nil)
+	if err != nil {
+		t.Errorf("Error retrieving network view: %s", err)
+	}
+	found := false
+	for _, view := range networkView.AssociatedDNSViews {
+		if view == testDNSView.Name {
+			found = true
+		}
+	}
+	if !found {
+		t.Errorf("Expected DNS view %s to be associated with network view %s", testDNSView.Name, testNetworkView.Name)
+	}
+}
+
+func TestUpdateDNSView(t *testing.T) {
+	view, err := viewClient.UpdateDNSView(testDNSView.Ref, DNSView{Recursion: newBool(true)})
+	if err != nil {
+		t.Errorf("Error updating DNS view: %s", err)
+	}
+	if view.Recursion == nil || !*view.Recursion {
+		t.Errorf("Error updating DNS view. Recursion does not match expected value")
+	}
+	testDNSView = view
+}
+
+func TestListViews(t *testing.T) {
+	views, err := viewClient.ListDNSViews(map[string]string{"network_view": testNetworkView.Name})
+	if err != nil {
+		t.Errorf("Error listing DNS views: %s", err)
+	}
+	if len(views) != 1 || views[0].Name != testDNSView.Name {
+		t.Errorf("Expected DNS view %s in network view %s", testDNSView.Name, testNetworkView.Name)
+	}
+	networkViews, err := viewClient.GetNetworkViewByQuery(map[string]string{"is_default": "true"})
+	if err != nil {
+		t.Errorf("Error querying network views: %s", err)
+	}
+	if len(networkViews) != 1 || networkViews[0].Name != "default" {
+		t.Errorf("Expected default network view")
+	}
+}
+
+func TestClientDefaultViews(t *testing.T) {
+	config := viewConfig
+	config.DNSView = testDNSView.Name
+	config.NetworkView = testNetworkView.Name
+	client := New(config)
+	defer client.Logout()
+
+	err := client.CreateNetwork(&testViewNetwork)
+	if err != nil {
+		t.Errorf("Error creating network: %s", err)
+	}
+	if testViewNetwork.NetworkView != testNetworkView.Name {
+		t.Errorf("Expected network view %s, got %s", testNetworkView.Name, testViewNetwork.NetworkView)
+	}
+	err = client.CreateARecord(&testViewARecord)
+	if err != nil {
+		t.Errorf("Error creating A record: %s", err)
+	}
+	if testViewARecord.View != testDNSView.Name {
+		t.Errorf("Expected DNS view %s, got %s", testDNSView.Name, testViewARecord.View)
+	}
+	addresses, err := client.GetUsedAddressesWithinRange(AddressQuery{
+		CIDR:         testViewNetwork.CIDR,
+		StartAddress: "172.19.210.1",
+		EndAddress:   "172.19.210.20",
+	})
+	if err != nil {
+		t.Errorf("Error retrieving used addresses: %s", err)
+	}
+	if len(*addresses) != 1 || (*addresses)[0].NetworkView != testNetworkView.Name {
+		t.Errorf("Expected 1 used address in network view %s", testNetworkView.Name)
+	}
+
+	err = viewClient.CreateNetwork(&testViewDefaultNetwork)
+	if err != nil {
+		t.Errorf("Error creating network in default view: %s", err)
+	}
+	networks, err := client.GetNetworkByQuery(map[string]string{"network": testViewNetwork.CIDR})
+	if err != nil {
+		t.Errorf("Error retrieving networks: %s", err)
+	}
+	if len(networks) != 1 || networks[0].NetworkView != testNetworkView.Name {
+		t.Errorf("Expected searches to be limited to network view %s, got %v", testNetworkView.Name, networks)
+	}
+	networks, err = client.ListNetworks(map[string]string{"network": testViewNetwork.CIDR, "network_view": "default"})
+	if err != nil {
+		t.Errorf("Error listing networks: %s", err)
+	}
+	if len(networks) != 1 || networks[0].NetworkView != "default" {
+		t.Errorf("Expected an explicit network view to be searched, got %v", networks)
+	}
+	records, err := client.ListARecords(map[string]string{"name": testViewARecord.Hostname})
+	if err != nil {
+		t.Errorf("Error listing A records: %s", err)
+	}
+	if len(records) != 1 || records[0].View != testDNSView.Name {
+		t.Errorf("Expected A records in DNS view %s, got %v", testDNSView.Name, records)
+	}
+}
+
+func TestDeleteViews(t *testing.T) {
+	if err := viewClient.DeleteARecord(testViewARecord.Ref); err != nil {
+		t.Errorf("Error deleting A record: %s", err)
+	}
+	if err := viewClient.DeleteNetwork(testViewNetwork.Ref); err != nil {
+		t.Errorf("Error deleting network: %s", err)
+	}
+	if err := viewClient.DeleteNetwork(testViewDefaultNetwork.Ref); err != nil {
+		t.Errorf("Error deleting network: %s", err)
+	}
+	if err := viewClient.DeleteDNSView(testDNSView.Ref); err != nil {
+		t.Errorf("Error deleting DNS view: %s", err)
+	}
+	if err := viewClient.DeleteNetworkView(testNetworkView.Ref); err != nil {
+		t.Errorf("Error deleting network view: %s", err)
+	}
+}
+
+func TestLogoutView(t *testing.T) {
+	err := viewClient.Logout()
+	if err != nil {
+		t.Errorf("Error logging out: %s", err)
+	}
+}
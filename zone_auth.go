@@ -0,0 +1,175 @@
+package infoblox
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+const (
+	zoneAuthBasePath     = "zone_auth"
+	zoneAuthReturnFields = "fqdn,view,zone_format,comment,disable,locked,ns_group,prefix,display_domain,dns_fqdn,primary_type,grid_primary,grid_secondaries,soa_default_ttl,soa_email,soa_expire,soa_negative_ttl,soa_refresh,soa_retry,soa_serial_number,use_grid_zone_timer,extattrs"
+)
+
+// Zone formats
+const (
+	ZoneFormatForward = "FORWARD"
+	ZoneFormatIPv4    = "IPV4"
+	ZoneFormatIPv6    = "IPV6"
+)
+
+// NewZoneMember returns a grid member server for zone primary and secondary assignment
+func NewZoneMember(hostname string) Member {
+	return Member{
+		StructType: "memberserver",
+		Hostname:   hostname,
+	}
+}
+
+// GetZoneAuthByRef gets authoritative zone by reference
+func (c *Client) GetZoneAuthByRef(ref string, queryParams map[string]string) (ZoneAuth, error) {
+	return c.GetZoneAuthByRefWithContext(context.Background(), ref, queryParams)
+}
+
+// GetZoneAuthByRefWithContext gets authoritative zone by reference using the supplied context
+func (c *Client) GetZoneAuthByRefWithContext(ctx context.Context, ref string, queryParams map[string]string) (ZoneAuth, error) {
+	var ret ZoneAuth
+	queryParams = returnFields(queryParams, zoneAuthReturnFields)
+
+	queryParamString := c.BuildQuery(queryParams)
+
+	request, err := c.CreateJSONRequestWithContext(ctx, http.MethodGet, fmt.Sprintf("%s?%s", ref, queryParamString), nil)
+	if err != nil {
+		return ret, err
+	}
+
+	response := c.Call(request, &ret)
+	if response != nil {
+		return ret, response
+	}
+
+	return ret, nil
+}
+
+// GetZoneAuthByQuery gets authoritative zones by query parameters
+func (c *Client) GetZoneAuthByQuery(queryParams map[string]string) ([]ZoneAuth, error) {
+	return c.GetZoneAuthByQueryWithContext(context.Background(), queryParams)
+}
+
+// GetZoneAuthByQueryWithContext gets authoritative zones by query parameters using the supplied context
+func (c *Client) GetZoneAuthByQueryWithContext(ctx context.Context, queryParams map[string]string) ([]ZoneAuth, error) {
+	return c.ListZoneAuthsWithContext(ctx, queryParams)
+}
+
+// ListZoneAuths lists all authoritative zones matching query parameters following every result page
+func (c *Client) ListZoneAuths(queryParams map[string]string) ([]ZoneAuth, error) {
+	return c.ListZoneAuthsWithContext(context.Background(), queryParams)
+}
+
+// ListZoneAuthsWithContext lists all authoritative zones matching query parameters following every result page using the supplied context
+func (c *Client) ListZoneAuthsWithContext(ctx context.Context, queryParams map[string]string) ([]ZoneAuth, error) {
+	return listWithReturnFields[ZoneAuth](ctx, c, zoneAuthBasePath, zoneAuthReturnFields, queryParams)
+}
+
+// CreateZoneAuth creates authoritative zone
+func (c *Client) CreateZoneAuth(zone *ZoneAuth) error {
+	return c.CreateZoneAuthWithContext(context.Background(), zone)
+}
+
+// CreateZoneAuthWithContext creates authoritative zone using the supplied context
+func (c *Client) CreateZoneAuthWithContext(ctx context.Context, zone *ZoneAuth) error {
+	zone.View = c.dnsView(zone.View)
+	queryParams := map[string]string{
+		"_return_fields": zoneAuthReturnFields,
+	}
+	queryParamString := c.BuildQuery(queryParams)
+	request, err := c.CreateJSONRequestWithContext(ctx, http.MethodPost, fmt.Sprintf("%s?%s", zoneAuthBasePath, queryParamString), zone)
+	if err != nil {
+		return err
+	}
+
+	response := c.Call(request, &zone)
+	if response != nil {
+		return response
+	}
+	return nil
+}
+
+// UpdateZoneAuth updates authoritative zone
+func (c *Client) UpdateZoneAuth(ref string, zone ZoneAuth) (ZoneAuth, error) {
+	return c.UpdateZoneAuthWithContext(context.Background(), ref, zone)
+}
+
+// UpdateZoneAuthWithContext updates authoritative zone using the supplied context
+func (c *Client) UpdateZoneAuthWithContext(ctx context.Context, ref string, zone ZoneAuth) (ZoneAuth, error) {
+	var ret ZoneAuth
+	queryParams := map[string]string{
+		"_return_fields": zoneAuthReturnFields,
+	}
+	queryParamString := c.BuildQuery(queryParams)
+	request, err := c.CreateJSONRequestWithContext(ctx, http.MethodPut, fmt.Sprintf("%s?%s", ref, queryParamString), zone)
+	if err != nil {
+		return ret, err
+	}
+
+	response := c.Call(request, &ret)
+	if response != nil {
+		return ret, response
+	}
+	return ret, nil
+}
+
+// DeleteZoneAuth deletes authoritative zone
+func (c *Client) DeleteZoneAuth(ref string) error {
+	return c.DeleteZoneAuthWithContext(context.Background(), ref)
+}
+
+// DeleteZoneAuthWithContext deletes authoritative zone using the supplied context
+func (c *Client) DeleteZoneAuthWithContext(ctx context.Context, ref string) error {
+	request, err := c.CreateJSONRequestWithContext(ctx, http.MethodDelete, fmt.Sprintf("%s", ref), nil)
+	if err != nil {
+		return err
+	}
+
+	response := c.Call(request, nil)
+	if response != nil {
+		if IsNotFound(response) {
+			return nil
+		}
+		return response
+	}
+	return nil
+}
+
+// GetZoneAuthForFQDN gets the most specific forward authoritative zone containing fqdn,
+// an empty view searches the configured default DNS view or every view when unset
+func (c *Client) GetZoneAuthForFQDN(fqdn string, view string) (ZoneAuth, error) {
+	return c.GetZoneAuthForFQDNWithContext(context.Background(), fqdn, view)
+}
+
+// GetZoneAuthForFQDNWithContext gets the most specific forward authoritative zone containing fqdn using the supplied context
+func (c *Client) GetZoneAuthForFQDNWithContext(ctx context.Context, fqdn string, view string) (ZoneAuth, error) {
+	var ret ZoneAuth
+
+	name := strings.ToLower(strings.TrimSuffix(fqdn, "."))
+	for name != "" {
+		queryParams := map[string]string{
+			"fqdn":        name,
+			"zone_format": ZoneFormatForward,
+		}
+		if view := c.dnsView(view); view != "" {
+			queryParams["view"] = view
+		}
+		zones, err := c.GetZoneAuthByQueryWithContext(ctx, queryParams)
+		if err != nil {
+			return ret, err
+		}
+		if len(zones) > 0 {
+			return zones[0], nil
+		}
+		_, name, _ = strings.Cut(name, ".")
+	}
+
+	return ret, fmt.Errorf("%w: no authoritative zone found for %s", ErrNotFound, fqdn)
+}
@@ -0,0 +1,135 @@
+//go:build all || unittests
+// +build all unittests
+
+package infoblox
+
+import (
+	"testing"
+)
+
+var (
+	zoneAuthConfig  = testConfig()
+	zoneAuthClient  = New(zoneAuthConfig)
+	testForwardZone = ZoneAuth{
+		FQDN:       "zonetest.auslab.cisco.com",
+		ZoneFormat: ZoneFormatForward,
+		View:       "default",
+		Comment:    "Zone testing",
+		ExtensibleAttributes: newExtensibleAttribute(ExtensibleAttribute{
+			"Owner": ExtensibleAttributeValue{
+				Value: "testUser",
+			},
+		}),
+	}
+	testReverseZone = ZoneAuth{
+		FQDN:       "172.19.200.0/24",
+		ZoneFormat: ZoneFormatIPv4,
+		View:       "default",
+	}
+	testIPv6ReverseZone = ZoneAuth{
+		FQDN:       "fd00:19:0:40::/64",
+		ZoneFormat: ZoneFormatIPv6,
+		View:       "default",
+	}
+)
+
+func TestCreateZoneAuth(t *testing.T) {
+	members, err := zoneAuthClient.GetGridMembersByQuery(nil)
+	if err != nil || len(members) == 0 {
+		t.Fatalf("Error retrieving grid members: %v", err)
+	}
+	testForwardZone.GridPrimary = []Member{NewZoneMember(members[0].Hostname)}
+	err = zoneAuthClient.CreateZoneAuth(&testForwardZone)
+	if err != nil {
+		t.Errorf("Error creating forward zone: %s", err)
+	}
+	if len(testForwardZone.GridPrimary) != 1 || testForwardZone.GridPrimary[0].Hostname != members[0].Hostname {
+		t.Errorf("Expected grid primary %s", members[0].Hostname)
+	}
+	if testForwardZone.PrimaryType != "Grid" {
+		t.Errorf("Expected primary type Grid, got %s", testForwardZone.PrimaryType)
+	}
+}
+
+func TestCreateReverseZoneAuth(t *testing.T) {
+	err := zoneAuthClient.CreateZoneAuth(&testReverseZone)
+	if err != nil {
+		t.Errorf("Error creating IPv4 reverse zone: %s", err)
+	}
+	if testReverseZone.DisplayDomain != "200.19.172.in-addr.arpa" {
+		t.Errorf("Expected display domain 200.19.172.in-addr.arpa, got %s", testReverseZone.DisplayDomain)
+	}
+	err = zoneAuthClient.CreateZoneAuth(&testIPv6ReverseZone)
+	if err != nil {
+		t.Errorf("Error creating IPv6 reverse zone: %s", err)
+	}
+	if testIPv6ReverseZone.DisplayDomain != "0.4.0.0.0.0.0.0.9.1.0.0.0.0.d.f.ip6.arpa" {
+		t.Errorf("Expected ip6.arpa display domain, got %s", testIPv6ReverseZone.DisplayDomain)
+	}
+}
+
+func TestUpdateZoneAuthSOA(t *testing.T) {
+	updates := ZoneAuth{
+		UseGridZoneTimer: newBool(true),
+		SOARefresh:       newInt(7200),
+		SOANegativeTTL:   newInt(300),
+		SOAEmail:         "dns-admin@auslab.cisco.com",
+	}
+	zone, err := zoneAuthClient.UpdateZoneAuth(testForwardZone.Ref, updates)
+	if err != nil {
+		t.Errorf("Error updating zone SOA: %s", err)
+	}
+	if zone.SOARefresh == nil || *zone.SOARefresh != 7200 || zone.SOANegativeTTL == nil || *zone.SOANegativeTTL != 300 {
+		t.Errorf("Error updating zone SOA. Timers do not match expected values")
+	}
+	if zone.SOAEmail != updates.SOAEmail {
+		t.Errorf("Expected SOA email %s, got %s", updates.SOAEmail, zone.SOAEmail)
+	}
+	testForwardZone = zone
+}
+
+func TestGetZoneAuthForFQDN(t *testing.T) {
+	zone, err := zoneAuthClient.GetZoneAuthForFQDN("Host.Sub.ZoneTest.auslab.cisco.com.", "default")
+	if err != nil {
+		t.Errorf("Error looking up zone: %s", err)
+	}
+	if zone.Ref != testForwardZone.Ref {
+		t.Errorf("Expected zone %s, got %s", testForwardZone.FQDN, zone.FQDN)
+	}
+	_, err = zoneAuthClient.GetZoneAuthForFQDN("host.example.invalid", "default")
+	if !IsNotFound(err) {
+		t.Errorf("Expected not found error, got %v", err)
+	}
+}
+
+func TestListZoneAuths(t *testing.T) {
+	zones, err := zoneAuthClient.ListZoneAuths(map[string]string{"zone_format": ZoneFormatIPv4})
+	if err != nil {
+		t.Errorf("Error listing zones: %s", err)
+	}
+	found := false
+	for _, zone := range zones {
+		if zone.Ref == testReverseZone.Ref {
+			found = true
+		}
+	}
+	if !found {
+		t.Errorf("Expected reverse zone %s in list", testReverseZone.FQDN)
+	}
+}
+
+func TestDeleteZoneAuth(t *testing.T) {
+	for _, ref := range []string{testForwardZone.Ref, testReverseZone.Ref, testIPv6ReverseZone.Ref} {
+		err := zoneAuthClient.DeleteZoneAuth(ref)
+		if err != nil {
+			t.Errorf("Error deleting zone: %s", err)
+		}
+	}
+}
+
+func TestLogoutZoneAuth(t *testing.T) {
+	err := zoneAuthClient.Logout()
+	if err != nil {
+		t.Errorf("Error logging out: %s", err)
+	}
+}
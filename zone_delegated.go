@@ -0,0 +1,127 @@
+package infoblox
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+const (
+	zoneDelegatedBasePath     = "zone_delegated"
+	zoneDelegatedReturnFields = "fqdn,view,zone_format,comment,disable,locked,ns_group,display_domain,dns_fqdn,delegate_to,delegated_ttl,use_delegated_ttl,extattrs"
+)
+
+// GetZoneDelegatedByRef gets delegated zone by reference
+func (c *Client) GetZoneDelegatedByRef(ref string, queryParams map[string]string) (ZoneDelegated, error) {
+	return c.GetZoneDelegatedByRefWithContext(context.Background(), ref, queryParams)
+}
+
+// GetZoneDelegatedByRefWithContext gets delegated zone by reference using the supplied context
+func (c *Client) GetZoneDelegatedByRefWithContext(ctx context.Context, ref string, queryParams map[string]string) (ZoneDelegated, error) {
+	var ret ZoneDelegated
+	queryParams = returnFields(queryParams, zoneDelegatedReturnFields)
+
+	queryParamString := c.BuildQuery(queryParams)
+
+	request, err := c.CreateJSONRequestWithContext(ctx, http.MethodGet, fmt.Sprintf("%s?%s", ref, queryParamString), nil)
+	if err != nil {
+		return ret, err
+	}
+
+	response := c.Call(request, &ret)
+	if response != nil {
+		return ret, response
+	}
+
+	return ret, nil
+}
+
+// GetZoneDelegatedByQuery gets delegated zones by query parameters
+func (c *Client) GetZoneDelegatedByQuery(queryParams map[string]string) ([]ZoneDelegated, error) {
+	return c.GetZoneDelegatedByQueryWithContext(context.Background(), queryParams)
+}
+
+// GetZoneDelegatedByQueryWithContext gets delegated zones by query parameters using the supplied context
+func (c *Client) GetZoneDelegatedByQueryWithContext(ctx context.Context, queryParams map[string]string) ([]ZoneDelegated, error) {
+	return c.ListZoneDelegatedWithContext(ctx, queryParams)
+}
+
+// ListZoneDelegated lists all delegated zones matching query parameters following every result page
+func (c *Client) ListZoneDelegated(queryParams map[string]string) ([]ZoneDelegated, error) {
+	return c.ListZoneDelegatedWithContext(context.Background(), queryParams)
+}
+
+// ListZoneDelegatedWithContext lists all delegated zones matching query parameters following every result page using the supplied context
+func (c *Client) ListZoneDelegatedWithContext(ctx context.Context, queryParams map[string]string) ([]ZoneDelegated, error) {
+	return listWithReturnFields[ZoneDelegated](ctx, c, zoneDelegatedBasePath, zoneDelegatedReturnFields, queryParams)
+}
+
+// CreateZoneDelegated creates delegated zone
+func (c *Client) CreateZoneDelegated(zone *ZoneDelegated) error {
+	return c.CreateZoneDelegatedWithContext(context.Background(), zone)
+}
+
+// CreateZoneDelegatedWithContext creates delegated zone using the supplied context
+func (c *Client) CreateZoneDelegatedWithContext(ctx context.Context, zone *ZoneDelegated) error {
+	zone.View = c.dnsView(zone.View)
+	queryParams := map[string]string{
+		"_return_fields": zoneDelegatedReturnFields,
+	}
+	queryParamString := c.BuildQuery(queryParams)
+	request, err := c.CreateJSONRequestWithContext(ctx, http.MethodPost, fmt.Sprintf("%s?%s", zoneDelegatedBasePath, queryParamString), zone)
+	if err != nil {
+		return err
+	}
+
+	response := c.Call(request, &zone)
+	if response != nil {
+		return response
+	}
+	return nil
+}
+
+// UpdateZoneDelegated updates delegated zone
+func (c *Client) UpdateZoneDelegated(ref string, zone ZoneDelegated) (ZoneDelegated, error) {
+	return c.UpdateZoneDelegatedWithContext(context.Background(), ref, zone)
+}
+
+// UpdateZoneDelegatedWithContext updates delegated zone using the supplied context
+func (c *Client) UpdateZoneDelegatedWithContext(ctx context.Context, ref string, zone ZoneDelegated) (ZoneDelegated, error) {
+	var ret ZoneDelegated
+	queryParams := map[string]string{
+		"_return_fields": zoneDelegatedReturnFields,
+	}
+	queryParamString := c.BuildQuery(queryParams)
+	request, err := c.CreateJSONRequestWithContext(ctx, http.MethodPut, fmt.Sprintf("%s?%s", ref, queryParamString), zone)
+	if err != nil {
+		return ret, err
+	}
+
+	response := c.Call(request, &ret)
+	if response != nil {
+		return ret, response
+	}
+	return ret, nil
+}
+
+// DeleteZoneDelegated deletes delegated zone
+func (c *Client) DeleteZoneDelegated(ref string) error {
+	return c.DeleteZoneDelegatedWithContext(context.Background(), ref)
+}
+
+// DeleteZoneDelegatedWithContext deletes delegated zone using the supplied context
+func (c *Client) DeleteZoneDelegatedWithContext(ctx context.Context, ref string) error {
+	request, err := c.CreateJSONRequestWithContext(ctx, http.MethodDelete, fmt.Sprintf("%s", ref), nil)
+	if err != nil {
+		return err
+	}
+
+	response := c.Call(request, nil)
+	if response != nil {
+		if IsNotFound(response) {
+			return nil
+		}
+		return response
+	}
+	return nil
+}
@@ -0,0 +1,716 @@
+package infoblox
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// ZoneFileRecord is a resource record read from or written to an RFC 1035
+// master file.  Name is fully qualified without a trailing dot and Data is in
+// presentation format with fully qualified names ending in a dot.  TTL is 0
+// when the record inherits the zone default TTL
+type ZoneFileRecord struct {
+	Name string
+	TTL  int
+	Type string
+	Data string
+}
+
+// String renders the record as a master file line with an absolute owner name
+func (r ZoneFileRecord) String() string {
+	if r.TTL > 0 {
+		return fmt.Sprintf("%s.\t%d\tIN\t%s\t%s", r.Name, r.TTL, r.Type, r.Data)
+	}
+	return fmt.Sprintf("%s.\tIN\t%s\t%s", r.Name, r.Type, r.Data)
+}
+
+// ZoneImportItem is a zone file record and the SDK object planned for it.
+// Object is a pointer to one of ARecord, AAAARecord, CNameRecord, PtrRecord,
+// MXRecord, TXTRecord or SRVRecord
+type ZoneImportItem struct {
+	Record ZoneFileRecord
+	Object interface{}
+}
+
+// ZoneImportConflict is a zone file record that cannot be created because of
+// an existing record
+type ZoneImportConflict struct {
+	Record   ZoneFileRecord
+	Existing ZoneFileRecord
+	Reason   string
+}
+
+// ZoneImportPlan lists the objects an import creates, the records already
+// present in the zone, the conflicts with existing records and the records
+// skipped because they are managed by the grid or not supported
+type ZoneImportPlan struct {
+	Zone      ZoneAuth
+	Create    []ZoneImportItem
+	Existing  []ZoneFileRecord
+	Conflicts []ZoneImportConflict
+	Skipped   []ZoneFileRecord
+}
+
+// zoneFileTypeOrder orders exported records after the SOA record
+var zoneFileTypeOrder = map[string]int{
+	"NS":    0,
+	"A":     1,
+	"AAAA":  2,
+	"CNAME": 3,
+	"MX":    4,
+	"TXT":   5,
+	"SRV":   6,
+	"PTR":   7,
+}
+
+// ExportZoneFile renders the records of an authoritative zone as an RFC 1035
+// master file.  Host records are expanded to A and AAAA records in forward
+// zones and to PTR records in reverse zones
+func (c *Client) ExportZoneFile(zoneRef string) (string, error) {
+	return c.ExportZoneFileWithContext(context.Background(), zoneRef)
+}
+
+// ExportZoneFileWithContext renders the records of an authoritative zone as an RFC 1035
+// master file using the supplied context
+func (c *Client) ExportZoneFileWithContext(ctx context.Context, zoneRef string) (string, error) {
+	zone, err := c.GetZoneAuthByRefWithContext(ctx, zoneRef, nil)
+	if err != nil {
+		return "", err
+	}
+	records, err := c.zoneRecords(ctx, zone)
+	if err != nil {
+		return "", err
+	}
+
+	origin := zoneOrigin(zone)
+	var builder strings.Builder
+	fmt.Fprintf(&builder, "$ORIGIN %s.\n", origin)
+	if zone.SOADefaultTTL != nil {
+		fmt.Fprintf(&builder, "$TTL %d\n", *zone.SOADefaultTTL)
+	}
+	fmt.Fprintf(&builder, "@\tIN\tSOA\t%s\n", zoneSOAData(zone, records))
+	for _, record := range records {
+		if record.TTL > 0 {
+			fmt.Fprintf(&builder, "%s\t%d\tIN\t%s\t%s\n", relativeName(record.Name, origin), record.TTL, record.Type, record.Data)
+		} else {
+			fmt.Fprintf(&builder, "%s\tIN\t%s\t%s\n", relativeName(record.Name, origin), record.Type, record.Data)
+		}
+	}
+	return builder.String(), nil
+}
+
+// ParseZoneFile parses an RFC 1035 master file.  Relative names are
+// qualified with origin until a $ORIGIN directive changes it
+func ParseZoneFile(r io.Reader, origin string) ([]ZoneFileRecord, error) {
+	var ret []ZoneFileRecord
+	origin = strings.ToLower(strings.TrimSuffix(origin, "."))
+	owner := ""
+
+	scanner := bufio.NewScanner(r)
+	lineNumber := 0
+	var pending []string
+	depth := 0
+	ownerBlank := false
+	for scanner.Scan() {
+		lineNumber++
+		line := scanner.Text()
+		tokens, err := zoneFileTokens(line)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", lineNumber, err)
+		}
+		if depth == 0 {
+			if len(tokens) == 0 {
+				continue
+			}
+			ownerBlank = line[0] == ' ' || line[0] == '\t'
+		}
+		for _, token := range tokens {
+			switch token {
+			case "(":
+				depth++
+			case ")":
+				depth--
+			default:
+				pending = append(pending, token)
+			}
+		}
+		if depth < 0 {
+			return nil, fmt.Errorf("line %d: unbalanced parentheses", lineNumber)
+		}
+		if depth > 0 {
+			continue
+		}
+		tokens, pending = pending, nil
+		if len(tokens) == 0 {
+			continue
+		}
+
+		switch strings.ToUpper(tokens[0]) {
+		case "$ORIGIN":
+			if len(tokens) != 2 {
+				return nil, fmt.Errorf("line %d: $ORIGIN requires a domain name", lineNumber)
+			}
+			origin = absoluteName(tokens[1], origin)
+			continue
+		case "$TTL":
+			if len(tokens) != 2 {
+				return nil, fmt.Errorf("line %d: $TTL requires a value", lineNumber)
+			}
+			// Records without a TTL inherit the zone default rather than
+			// pinning $TTL on every imported record
+			if _, err := parseZoneFileTTL(tokens[1]); err != nil {
+				return nil, fmt.Errorf("line %d: %w", lineNumber, err)
+			}
+			continue
+		case "$INCLUDE", "$GENERATE":
+			return nil, fmt.Errorf("line %d: %s is not supported", lineNumber, tokens[0])
+		}
+
+		if !ownerBlank {
+			owner = absoluteName(tokens[0], origin)
+			tokens = tokens[1:]
+		}
+		if owner == "" {
+			return nil, fmt.Errorf("line %d: record has no owner name", lineNumber)
+		}
+		record := ZoneFileRecord{Name: owner}
+		for len(tokens) > 0 {
+			if strings.EqualFold(tokens[0], "IN") {
+				tokens = tokens[1:]
+				continue
+			}
+			if ttl, err := parseZoneFileTTL(tokens[0]); err == nil {
+				record.TTL = ttl
+				tokens = tokens[1:]
+				continue
+			}
+			break
+		}
+		if len(tokens) < 2 {
+			return nil, fmt.Errorf("line %d: record requires a type and data", lineNumber)
+		}
+		record.Type = strings.ToUpper(tokens[0])
+		record.Data, err = normalizeRecordData(record.Type, tokens[1:], origin)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", lineNumber, err)
+		}
+		ret = append(ret, record)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	if depth != 0 {
+		return nil, fmt.Errorf("line %d: unbalanced parentheses", lineNumber)
+	}
+	return ret, nil
+}
+
+// PlanZoneImport compares zone file records with the records of an authoritative
+// zone and plans the objects to create
+func (c *Client) PlanZoneImport(zoneRef string, records []ZoneFileRecord) (ZoneImportPlan, error) {
+	return c.PlanZoneImportWithContext(context.Background(), zoneRef, records)
+}
+
+// PlanZoneImportWithContext compares zone file records with the records of an authoritative
+// zone and plans the objects to create using the supplied context
+func (c *Client) PlanZoneImportWithContext(ctx context.Context, zoneRef string, records []ZoneFileRecord) (ZoneImportPlan, error) {
+	var ret ZoneImportPlan
+
+	zone, err := c.GetZoneAuthByRefWithContext(ctx, zoneRef, nil)
+	if err != nil {
+		return ret, err
+	}
+	ret.Zone = zone
+	existing, err := c.zoneRecords(ctx, zone)
+	if err != nil {
+		return ret, err
+	}
+	byName := map[string][]ZoneFileRecord{}
+	for _, record := range existing {
+		byName[record.Name] = append(byName[record.Name], record)
+	}
+
+	origin := zoneOrigin(zone)
+	for _, record := range records {
+		record.Name = strings.ToLower(record.Name)
+		if record.Name != origin && !strings.HasSuffix(record.Name, "."+origin) {
+			ret.Skipped = append(ret.Skipped, record)
+			continue
+		}
+		object := zoneImportObject(record, zone.View)
+		if object == nil {
+			ret.Skipped = append(ret.Skipped, record)
+			continue
+		}
+		if found, conflict := findZoneImportConflict(record, byName[record.Name]); found != nil {
+			if conflict == "" {
+				ret.Existing = append(ret.Existing, record)
+			} else {
+				ret.Conflicts = append(ret.Conflicts, ZoneImportConflict{Record: record, Existing: *found, Reason: conflict})
+			}
+			continue
+		}
+		ret.Create = append(ret.Create, ZoneImportItem{Record: record, Object: object})
+		byName[record.Name] = append(byName[record.Name], record)
+	}
+	return ret, nil
+}
+
+// ApplyZoneImport creates the objects planned by PlanZoneImport, conflicts
+// and skipped records are left for the caller to resolve
+func (c *Client) ApplyZoneImport(plan ZoneImportPlan) error {
+	return c.ApplyZoneImportWithContext(context.Background(), plan)
+}
+
+// ApplyZoneImportWithContext creates the objects planned by PlanZoneImport using the supplied context
+func (c *Client) ApplyZoneImportWithContext(ctx context.Context, plan ZoneImportPlan) error {
+	for _, item := range plan.Create {
+		var err error
+		switch object := item.Object.(type) {
+		case *ARecord:
+			err = c.CreateARecordWithContext(ctx, object)
+		case *AAAARecord:
+			err = c.CreateAAAARecordWithContext(ctx, object)
+		case *CNameRecord:
+			err = c.CreateCNameRecordWithContext(ctx, object)
+		case *PtrRecord:
+			err = c.CreatePtrRecordWithContext(ctx, object)
+		case *MXRecord:
+			err = c.CreateMXRecordWithContext(ctx, object)
+		case *TXTRecord:
+			err = c.CreateTXTRecordWithContext(ctx, object)
+		case *SRVRecord:
+			err = c.CreateSRVRecordWithContext(ctx, object)
+		default:
+			err = fmt.Errorf("unsupported object %T", item.Object)
+		}
+		if err != nil {
+			return fmt.Errorf("creating %s: %w", item.Record, err)
+		}
+	}
+	return nil
+}
+
+// zoneRecords lists the records of a zone as zone file records sorted by
+// name and type
+func (c *Client) zoneRecords(ctx context.Context, zone ZoneAuth) ([]ZoneFileRecord, error) {
+	var ret []ZoneFileRecord
+	origin := zoneOrigin(zone)
+	queryParams := func() map[string]string {
+		return map[string]string{"zone": origin, "view": zone.View, "_return_fields+": "ttl,use_ttl"}
+	}
+	add := func(name string, recordType string, data string, ttl int) {
+		ret = append(ret, ZoneFileRecord{Name: strings.ToLower(name), TTL: ttl, Type: recordType, Data: data})
+	}
+
+	nsRecords, err := c.ListNSRecordsWithContext(ctx, map[string]string{"zone": origin, "view": zone.View})
+	if err != nil {
+		return nil, err
+	}
+	for _, record := range nsRecords {
+		add(record.Name, "NS", fqdnData(record.NameServer), 0)
+	}
+	if zone.ZoneFormat == "" || zone.ZoneFormat == ZoneFormatForward {
+		aRecords, err := c.ListARecordsWithContext(ctx, queryParams())
+		if err != nil {
+			return nil, err
+		}
+		for _, record := range aRecords {
+			add(record.Hostname, "A", record.IPAddress, recordTTL(record.TTL, record.UseTTL))
+		}
+		aaaaRecords, err := c.ListAAAARecordsWithContext(ctx, queryParams())
+		if err != nil {
+			return nil, err
+		}
+		for _, record := range aaaaRecords {
+			add(record.Hostname, "AAAA", record.IPAddress, recordTTL(record.TTL, record.UseTTL))
+		}
+		hostRecords, err := c.ListHostRecordsWithContext(ctx, queryParams())
+		if err != nil {
+			return nil, err
+		}
+		for _, record := range hostRecords {
+			ttl := recordTTL(record.TTL, record.UseTTL)
+			for _, address := range record.IPv4Addrs {
+				add(record.Hostname, "A", address.IPAddress, ttl)
+			}
+			for _, address := range record.IPv6Addrs {
+				add(record.Hostname, "AAAA", address.IPAddress, ttl)
+			}
+		}
+		cNameRecords, err := c.ListCNameRecordsWithContext(ctx, queryParams())
+		if err != nil {
+			return nil, err
+		}
+		for _, record := range cNameRecords {
+			add(record.Alias, "CNAME", fqdnData(record.Canonical), recordTTL(record.TTL, record.UseTTL))
+		}
+		mxRecords, err := c.ListMXRecordsWithContext(ctx, queryParams())
+		if err != nil {
+			return nil, err
+		}
+		for _, record := range mxRecords {
+			add(record.Name, "MX", fmt.Sprintf("%d %s", intValue(record.Preference), fqdnData(record.MailExchanger)),
+				recordTTL(record.TTL, record.UseTTL))
+		}
+		txtRecords, err := c.ListTXTRecordsWithContext(ctx, queryParams())
+		if err != nil {
+			return nil, err
+		}
+		for _, record := range txtRecords {
+			add(record.Name, "TXT", txtData(record.Text), recordTTL(record.TTL, record.UseTTL))
+		}
+		srvRecords, err := c.ListSRVRecordsWithContext(ctx, queryParams())
+		if err != nil {
+			return nil, err
+		}
+		for _, record := range srvRecords {
+			add(record.Name, "SRV", fmt.Sprintf("%d %d %d %s", intValue(record.Priority), intValue(record.Weight),
+				intValue(record.Port), fqdnData(record.Target)), recordTTL(record.TTL, record.UseTTL))
+		}
+	} else {
+		ptrRecords, err := c.ListPtrRecordsWithContext(ctx, queryParams())
+		if err != nil {
+			return nil, err
+		}
+		for _, record := range ptrRecords {
+			add(record.Name, "PTR", fqdnData(record.PointerDomainName), recordTTL(record.TTL, record.UseTTL))
+		}
+		_, network, err := net.ParseCIDR(zone.FQDN)
+		if err != nil {
+			return nil, fmt.Errorf("invalid reverse zone %s: %w", zone.FQDN, err)
+		}
+		hostRecords, err := c.ListHostRecordsWithContext(ctx, map[string]string{"view": zone.View, "_return_fields+": "ttl,use_ttl"})
+		if err != nil {
+			return nil, err
+		}
+		for _, record := range hostRecords {
+			var addresses []string
+			for _, address := range record.IPv4Addrs {
+				addresses = append(addresses, address.IPAddress)
+			}
+			for _, address := range record.IPv6Addrs {
+				addresses = append(addresses, address.IPAddress)
+			}
+			for _, address := range addresses {
+				if ip := net.ParseIP(address); ip != nil && network.Contains(ip) {
+					add(reverseName(ip), "PTR", fqdnData(record.Hostname), recordTTL(record.TTL, record.UseTTL))
+				}
+			}
+		}
+	}
+
+	sort.SliceStable(ret, func(i, j int) bool {
+		if ret[i].Name != ret[j].Name {
+			if ret[i].Name == origin || ret[j].Name == origin {
+				return ret[i].Name == origin
+			}
+			return ret[i].Name < ret[j].Name
+		}
+		if ret[i].Type != ret[j].Type {
+			return zoneFileTypeOrder[ret[i].Type] < zoneFileTypeOrder[ret[j].Type]
+		}
+		return ret[i].Data < ret[j].Data
+	})
+	return ret, nil
+}
+
+// zoneImportObject returns the SDK object for a zone file record or nil when
+// the record type is managed by the grid or not supported
+func zoneImportObject(record ZoneFileRecord, view string) interface{} {
+	fields := strings.Fields(record.Data)
+	var ttl *int
+	var useTTL *bool
+	if record.TTL > 0 {
+		ttl, useTTL = newInt(record.TTL), newBool(true)
+	}
+	switch record.Type {
+	case "A":
+		return &ARecord{Hostname: record.Name, IPAddress: record.Data, View: view, TTL: ttl, UseTTL: useTTL}
+	case "AAAA":
+		return &AAAARecord{Hostname: record.Name, IPAddress: record.Data, View: view, TTL: ttl, UseTTL: useTTL}
+	case "CNAME":
+		return &CNameRecord{Alias: record.Name, Canonical: strings.TrimSuffix(record.Data, "."), View: view, TTL: ttl, UseTTL: useTTL}
+	case "PTR":
+		return &PtrRecord{Name: record.Name, PointerDomainName: strings.TrimSuffix(record.Data, "."), View: view, TTL: ttl, UseTTL: useTTL}
+	case "MX":
+		preference, _ := strconv.Atoi(fields[0])
+		return &MXRecord{Name: record.Name, Preference: newInt(preference), MailExchanger: strings.TrimSuffix(fields[1], "."), View: view,
+			TTL: ttl, UseTTL: useTTL}
+	case "TXT":
+		return &TXTRecord{Name: record.Name, Text: UnquoteTXT(record.Data), View: view, TTL: ttl, UseTTL: useTTL}
+	case "SRV":
+		var values [3]int
+		for i := range values {
+			values[i], _ = strconv.Atoi(fields[i])
+		}
+		return &SRVRecord{Name: record.Name, Priority: newInt(values[0]), Weight: newInt(values[1]), Port: newInt(values[2]),
+			Target: strings.TrimSuffix(fields[3], "."), View: view, TTL: ttl, UseTTL: useTTL}
+	}
+	return nil
+}
+
+// recordTTL returns the TTL of a record overriding the zone default or 0
+// when the record inherits it
+func recordTTL(ttl *int, useTTL *bool) int {
+	if ttl == nil || useTTL == nil || !*useTTL {
+		return 0
+	}
+	return *ttl
+}
+
+// findZoneImportConflict returns the existing record matching or conflicting
+// with record and an empty reason when it is identical
+func findZoneImportConflict(record ZoneFileRecord, existing []ZoneFileRecord) (*ZoneFileRecord, string) {
+	for i, other := range existing {
+		if other.Type == record.Type && strings.EqualFold(zoneRecordKey(other), zoneRecordKey(record)) {
+			return &existing[i], ""
+		}
+	}
+	for i, other := range existing {
+		switch {
+		case record.Type == "CNAME" && other.Type == "CNAME":
+			return &existing[i], "a CNAME record with a different canonical name exists"
+		case record.Type == "CNAME":
+			return &existing[i], fmt.Sprintf("a CNAME record cannot coexist with the existing %s record", other.Type)
+		case other.Type == "CNAME":
+			return &existing[i], "an existing CNAME record cannot coexist with other records"
+		}
+	}
+	return nil, ""
+}
+
+// zoneRecordKey returns the comparable data of a record
+func zoneRecordKey(record ZoneFileRecord) string {
+	switch record.Type {
+	case "TXT":
+		return UnquoteTXT(record.Data)
+	case "A", "AAAA":
+		if ip := net.ParseIP(record.Data); ip != nil {
+			return ip.String()
+		}
+	}
+	return record.Data
+}
+
+// normalizeRecordData converts record data tokens to presentation format with
+// fully qualified names
+func normalizeRecordData(recordType string, tokens []string, origin string) (string, error) {
+	invalid := fmt.Errorf("invalid %s record data %q", recordType, strings.Join(tokens, " "))
+	switch recordType {
+	case "A", "AAAA":
+		ip := net.ParseIP(tokens[0])
+		if len(tokens) != 1 || ip == nil || (ip.To4() != nil) != (recordType == "A") {
+			return "", invalid
+		}
+		return ip.String(), nil
+	case "CNAME", "PTR", "NS":
+		if len(tokens) != 1 {
+			return "", invalid
+		}
+		return fqdnData(absoluteName(tokens[0], origin)), nil
+	case "MX":
+		if len(tokens) != 2 || !isUint16(tokens[0]) {
+			return "", invalid
+		}
+		return fmt.Sprintf("%s %s", tokens[0], fqdnData(absoluteName(tokens[1], origin))), nil
+	case "SRV":
+		if len(tokens) != 4 || !isUint16(tokens[0]) || !isUint16(tokens[1]) || !isUint16(tokens[2]) {
+			return "", invalid
+		}
+		return fmt.Sprintf("%s %s %s %s", tokens[0], tokens[1], tokens[2], fqdnData(absoluteName(tokens[3], origin))), nil
+	case "TXT":
+		var chunks []string
+		for _, token := range tokens {
+			if !strings.HasPrefix(token, `"`) {
+				token = txtData(token)
+			}
+			chunks = append(chunks, token)
+		}
+		return strings.Join(chunks, " "), nil
+	case "SOA":
+		if len(tokens) != 7 {
+			return "", invalid
+		}
+		return fmt.Sprintf("%s %s %s", fqdnData(absoluteName(tokens[0], origin)), fqdnData(absoluteName(tokens[1], origin)),
+			strings.Join(tokens[2:], " ")), nil
+	}
+	return strings.Join(tokens, " "), nil
+}
+
+// zoneFileTokens splits a master file line into tokens, keeping quoted
+// strings with their quotes and dropping comments
+func zoneFileTokens(line string) ([]string, error) {
+	var tokens []string
+	var current strings.Builder
+	inToken, quoted, escaped := false, false, false
+	flush := func() {
+		if inToken {
+			tokens = append(tokens, current.String())
+			current.Reset()
+			inToken = false
+		}
+	}
+	for _, r := range line {
+		switch {
+		case escaped:
+			current.WriteRune(r)
+			escaped = false
+		case r == '\\':
+			current.WriteRune(r)
+			inToken, escaped = true, true
+		case quoted:
+			current.WriteRune(r)
+			if r == '"' {
+				quoted = false
+				flush()
+			}
+		case r == '"':
+			flush()
+			current.WriteRune(r)
+			inToken, quoted = true, true
+		case r == ';':
+			flush()
+			return tokens, nil
+		case r == '(' || r == ')':
+			flush()
+			tokens = append(tokens, string(r))
+		case r == ' ' || r == '\t':
+			flush()
+		default:
+			current.WriteRune(r)
+			inToken = true
+		}
+	}
+	if quoted {
+		return nil, fmt.Errorf("unterminated quoted string")
+	}
+	flush()
+	return tokens, nil
+}
+
+// parseZoneFileTTL parses a TTL in seconds or with BIND style unit suffixes
+func parseZoneFileTTL(value string) (int, error) {
+	units := map[byte]int{'s': 1, 'm': 60, 'h': 3600, 'd': 86400, 'w': 604800}
+	total, current, digits := 0, 0, false
+	for i := 0; i < len(value); i++ {
+		ch := value[i]
+		switch {
+		case ch >= '0' && ch <= '9':
+			current = current*10 + int(ch-'0')
+			digits = true
+		case digits && units[ch|0x20] > 0:
+			total += current * units[ch|0x20]
+			current, digits = 0, false
+		default:
+			return 0, fmt.Errorf("invalid TTL %q", value)
+		}
+	}
+	if value == "" {
+		return 0, fmt.Errorf("invalid TTL %q", value)
+	}
+	return total + current, nil
+}
+
+// zoneOrigin returns the domain name of a forward or reverse zone
+func zoneOrigin(zone ZoneAuth) string {
+	origin := zone.DisplayDomain
+	if origin == "" {
+		origin = zone.FQDN
+	}
+	return strings.ToLower(strings.TrimSuffix(origin, "."))
+}
+
+// zoneSOAData renders the SOA record data of a zone
+func zoneSOAData(zone ZoneAuth, records []ZoneFileRecord) string {
+	origin := zoneOrigin(zone)
+	primary := origin
+	if len(zone.GridPrimary) > 0 {
+		primary = zone.GridPrimary[0].Hostname
+	} else {
+		for _, record := range records {
+			if record.Type == "NS" && record.Name == origin {
+				primary = record.Data
+				break
+			}
+		}
+	}
+	email := zone.SOAEmail
+	if email == "" {
+		email = "hostmaster@" + origin
+	}
+	return fmt.Sprintf("%s %s ( %d %d %d %d %d )", fqdnData(primary), fqdnData(strings.Replace(email, "@", ".", 1)),
+		intValue(zone.SOASerialNumber), intValue(zone.SOARefresh), intValue(zone.SOARetry), intValue(zone.SOAExpire),
+		intValue(zone.SOANegativeTTL))
+}
+
+// absoluteName qualifies a master file name with origin
+func absoluteName(name string, origin string) string {
+	switch {
+	case name == "@":
+		return origin
+	case strings.HasSuffix(name, "."):
+		return strings.ToLower(strings.TrimSuffix(name, "."))
+	case origin == "":
+		return strings.ToLower(name)
+	}
+	return strings.ToLower(name + "." + origin)
+}
+
+// relativeName returns name relative to origin for a master file
+func relativeName(name string, origin string) string {
+	switch {
+	case name == origin:
+		return "@"
+	case strings.HasSuffix(name, "."+origin):
+		return strings.TrimSuffix(name, "."+origin)
+	}
+	return name + "."
+}
+
+// reverseName returns the in-addr.arpa or ip6.arpa name of an address
+func reverseName(ip net.IP) string {
+	var labels []string
+	if v4 := ip.To4(); v4 != nil {
+		for i := len(v4) - 1; i >= 0; i-- {
+			labels = append(labels, strconv.Itoa(int(v4[i])))
+		}
+		return strings.Join(append(labels, "in-addr", "arpa"), ".")
+	}
+	digits := fmt.Sprintf("%x", []byte(ip.To16()))
+	for i := len(digits) - 1; i >= 0; i-- {
+		labels = append(labels, digits[i:i+1])
+	}
+	return strings.Join(append(labels, "ip6", "arpa"), ".")
+}
+
+// fqdnData returns a fully qualified name with a trailing dot
+func fqdnData(name string) string {
+	return strings.ToLower(strings.TrimSuffix(name, ".")) + "."
+}
+
+// txtData returns TXT record text as quoted character strings
+func txtData(text string) string {
+	if text == "" {
+		return `""`
+	}
+	return QuoteTXT(text)
+}
+
+func isUint16(value string) bool {
+	number, err := strconv.Atoi(value)
+	return err == nil && number >= 0 && number <= 65535
+}
+
+func intValue(value *int) int {
+	if value == nil {
+		return 0
+	}
+	return *value
+}
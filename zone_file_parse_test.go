@@ -0,0 +1,159 @@
+package infoblox
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestParseZoneFile(t *testing.T) {
+	zoneFile := `$ORIGIN example.com.
+$TTL 1h
+@	IN	SOA	ns1 hostmaster (
+		2021010101 ; serial
+		7200 3600 1209600 300 )
+	IN	NS	ns1
+ns1	IN	A	192.0.2.1
+www	300	IN	A	192.0.2.10
+	IN	300	AAAA	2001:db8::10
+mail	MX	10 mx.example.net.
+ftp	CNAME	www
+txt	TXT	"v=spf1 -all" "second string"
+_sip._tcp	SRV	10 60 5060 sip
+$ORIGIN sub.example.com.
+host	A	192.0.2.20
+`
+	records, err := ParseZoneFile(strings.NewReader(zoneFile), "")
+	if err != nil {
+		t.Fatalf("Error parsing zone file: %s", err)
+	}
+	expected := []ZoneFileRecord{
+		{Name: "example.com", Type: "SOA", Data: "ns1.example.com. hostmaster.example.com. 2021010101 7200 3600 1209600 300"},
+		{Name: "example.com", Type: "NS", Data: "ns1.example.com."},
+		{Name: "ns1.example.com", Type: "A", Data: "192.0.2.1"},
+		{Name: "www.example.com", TTL: 300, Type: "A", Data: "192.0.2.10"},
+		{Name: "www.example.com", TTL: 300, Type: "AAAA", Data: "2001:db8::10"},
+		{Name: "mail.example.com", Type: "MX", Data: "10 mx.example.net."},
+		{Name: "ftp.example.com", Type: "CNAME", Data: "www.example.com."},
+		{Name: "txt.example.com", Type: "TXT", Data: `"v=spf1 -all" "second string"`},
+		{Name: "_sip._tcp.example.com", Type: "SRV", Data: "10 60 5060 sip.example.com."},
+		{Name: "host.sub.example.com", Type: "A", Data: "192.0.2.20"},
+	}
+	if len(records) != len(expected) {
+		t.Fatalf("Expected %d records, got %d: %v", len(expected), len(records), records)
+	}
+	for i, record := range records {
+		if record != expected[i] {
+			t.Errorf("Expected record %v, got %v", expected[i], record)
+		}
+	}
+}
+
+func TestParseZoneFileErrors(t *testing.T) {
+	tests := map[string]string{
+		"unbalanced parentheses": "@ IN SOA ns1 hostmaster ( 1 2 3 4 5\n",
+		"unterminated string":    "txt IN TXT \"unterminated\n",
+		"invalid address":        "www IN A 2001:db8::1\n",
+		"invalid mx":             "@ IN MX mail\n",
+		"include":                "$INCLUDE other.zone\n",
+		"missing owner":          "\tIN A 192.0.2.1\n",
+	}
+	for name, zoneFile := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := ParseZoneFile(strings.NewReader(zoneFile), "example.com")
+			if err == nil {
+				t.Errorf("Expected parse error")
+			}
+		})
+	}
+}
+
+func TestZoneFileTTLRoundTrip(t *testing.T) {
+	client, _ := newPaginationTestClient(t, 0)
+	zone := ZoneAuth{FQDN: "ttl.example.com", ZoneFormat: ZoneFormatForward, SOADefaultTTL: newInt(3600)}
+	if err := client.CreateZoneAuth(&zone); err != nil {
+		t.Fatalf("Error creating zone: %s", err)
+	}
+	record := ARecord{Hostname: "www.ttl.example.com", IPAddress: "10.1.1.10", TTL: newInt(600), UseTTL: newBool(true)}
+	if err := client.CreateARecord(&record); err != nil {
+		t.Fatalf("Error creating A record: %s", err)
+	}
+	if err := client.CreateARecord(&ARecord{Hostname: "api.ttl.example.com", IPAddress: "10.1.1.11"}); err != nil {
+		t.Fatalf("Error creating A record: %s", err)
+	}
+
+	zoneFile, err := client.ExportZoneFile(zone.Ref)
+	if err != nil {
+		t.Fatalf("Error exporting zone file: %s", err)
+	}
+	if !strings.Contains(zoneFile, "www\t600\tIN\tA\t10.1.1.10\n") || !strings.Contains(zoneFile, "api\tIN\tA\t10.1.1.11\n") {
+		t.Errorf("Expected record TTL only where it overrides the zone default, got:\n%s", zoneFile)
+	}
+
+	records, err := ParseZoneFile(strings.NewReader(zoneFile), "")
+	if err != nil {
+		t.Fatalf("Error parsing zone file: %s", err)
+	}
+	if err := client.DeleteARecord(record.Ref); err != nil {
+		t.Fatalf("Error deleting A record: %s", err)
+	}
+	plan, err := client.PlanZoneImport(zone.Ref, records)
+	if err != nil {
+		t.Fatalf("Error planning zone import: %s", err)
+	}
+	if len(plan.Create) != 1 {
+		t.Fatalf("Expected 1 record to create, got %d", len(plan.Create))
+	}
+	if err := client.ApplyZoneImport(plan); err != nil {
+		t.Fatalf("Error applying zone import: %s", err)
+	}
+	imported, err := client.GetARecordByRef(plan.Create[0].Object.(*ARecord).Ref, NewQuery().ReturnFields("ttl", "use_ttl"))
+	if err != nil {
+		t.Fatalf("Error getting imported A record: %s", err)
+	}
+	if imported.TTL == nil || *imported.TTL != 600 || imported.UseTTL == nil || !*imported.UseTTL {
+		t.Errorf("Expected imported TTL 600, got %v", imported.TTL)
+	}
+
+	existing, err := client.GetARecordByQuery(NewQuery().Equal("zone", zone.FQDN))
+	if err != nil {
+		t.Fatalf("Error getting A records: %s", err)
+	}
+	for _, record := range existing {
+		if err := client.DeleteARecord(record.Ref); err != nil {
+			t.Fatalf("Error deleting A record: %s", err)
+		}
+	}
+	if err := client.DeleteZoneAuth(zone.Ref); err != nil {
+		t.Fatalf("Error deleting zone: %s", err)
+	}
+	fresh := ZoneAuth{FQDN: zone.FQDN, ZoneFormat: ZoneFormatForward, SOADefaultTTL: newInt(3600)}
+	if err := client.CreateZoneAuth(&fresh); err != nil {
+		t.Fatalf("Error creating zone: %s", err)
+	}
+	plan, err = client.PlanZoneImport(fresh.Ref, records)
+	if err != nil {
+		t.Fatalf("Error planning zone import: %s", err)
+	}
+	if err := client.ApplyZoneImport(plan); err != nil {
+		t.Fatalf("Error applying zone import: %s", err)
+	}
+	aRecords, err := client.GetARecordByQuery(NewQuery().Equal("zone", fresh.FQDN).ReturnFields("ttl", "use_ttl"))
+	if err != nil {
+		t.Fatalf("Error getting imported A records: %s", err)
+	}
+	if len(aRecords) != 2 {
+		t.Fatalf("Expected 2 imported A records, got %v", aRecords)
+	}
+	for _, record := range aRecords {
+		switch record.Hostname {
+		case "api.ttl.example.com":
+			if record.UseTTL != nil && *record.UseTTL {
+				t.Errorf("Expected api to inherit the zone default TTL, got %v", *record.TTL)
+			}
+		case "www.ttl.example.com":
+			if record.TTL == nil || *record.TTL != 600 || record.UseTTL == nil || !*record.UseTTL {
+				t.Errorf("Expected www to keep TTL 600, got %v", record.TTL)
+			}
+		}
+	}
+}
@@ -0,0 +1,184 @@
+//go:build all || unittests
+// +build all unittests
+
+package infoblox
+
+import (
+	"strings"
+	"testing"
+)
+
+var (
+	zoneFileConfig   = testConfig()
+	zoneFileClient   = New(zoneFileConfig)
+	testZoneFileZone = ZoneAuth{
+		FQDN:       "zonefile.auslab.cisco.com",
+		ZoneFormat: ZoneFormatForward,
+		View:       "default",
+	}
+	testZoneFileReverseZone = ZoneAuth{
+		FQDN:       "172.19.201.0/24",
+		ZoneFormat: ZoneFormatIPv4,
+		View:       "default",
+	}
+	testZoneFileRefs []string
+)
+
+func TestCreateZoneFileRecords(t *testing.T) {
+	members, err := zoneFileClient.GetGridMembersByQuery(nil)
+	if err != nil || len(members) == 0 {
+		t.Fatalf("Error retrieving grid members: %v", err)
+	}
+	testZoneFileZone.GridPrimary = []Member{NewZoneMember(members[0].Hostname)}
+	for _, zone := range []*ZoneAuth{&testZoneFileZone, &testZoneFileReverseZone} {
+		if err := zoneFileClient.CreateZoneAuth(zone); err != nil {
+			t.Fatalf("Error creating zone %s: %s", zone.FQDN, err)
+		}
+	}
+
+	aRecord := ARecord{Hostname: "www.zonefile.auslab.cisco.com", IPAddress: "172.19.201.10", View: "default"}
+	cNameRecord := CNameRecord{Alias: "ftp.zonefile.auslab.cisco.com", Canonical: "www.zonefile.auslab.cisco.com", View: "default"}
+	mxRecord := MXRecord{Name: "zonefile.auslab.cisco.com", Preference: newInt(10), MailExchanger: "mail.auslab.cisco.com", View: "default"}
+	txtRecord := TXTRecord{Name: "zonefile.auslab.cisco.com", Text: "v=spf1 -all", View: "default"}
+	hostRecord := HostRecord{
+		Hostname:  "app.zonefile.auslab.cisco.com",
+		EnableDNS: newBool(true),
+		IPv4Addrs: []IPv4Addr{{IPAddress: "172.19.201.20"}},
+		View:      "default",
+	}
+	steps := []struct {
+		name   string
+		create func() error
+		ref    *string
+	}{
+		{"A", func() error { return zoneFileClient.CreateARecord(&aRecord) }, &aRecord.Ref},
+		{"CNAME", func() error { return zoneFileClient.CreateCNameRecord(&cNameRecord) }, &cNameRecord.Ref},
+		{"MX", func() error { return zoneFileClient.CreateMXRecord(&mxRecord) }, &mxRecord.Ref},
+		{"TXT", func() error { return zoneFileClient.CreateTXTRecord(&txtRecord) }, &txtRecord.Ref},
+		{"host", func() error { return zoneFileClient.CreateHostRecord(&hostRecord) }, &hostRecord.Ref},
+	}
+	for _, step := range steps {
+		if err := step.create(); err != nil {
+			t.Fatalf("Error creating %s record: %s", step.name, err)
+		}
+		testZoneFileRefs = append(testZoneFileRefs, *step.ref)
+	}
+}
+
+func TestExportZoneFile(t *testing.T) {
+	zoneFile, err := zoneFileClient.ExportZoneFile(testZoneFileZone.Ref)
+	if err != nil {
+		t.Fatalf("Error exporting zone file: %s", err)
+	}
+	for _, line := range []string{
+		"$ORIGIN zonefile.auslab.cisco.com.\n",
+		"@\tIN\tSOA\t",
+		"@\tIN\tMX\t10 mail.auslab.cisco.com.\n",
+		"@\tIN\tTXT\t\"v=spf1 -all\"\n",
+		"app\tIN\tA\t172.19.201.20\n",
+		"ftp\tIN\tCNAME\twww.zonefile.auslab.cisco.com.\n",
+		"www\tIN\tA\t172.19.201.10\n",
+	} {
+		if !strings.Contains(zoneFile, line) {
+			t.Errorf("Expected exported zone file to contain %q, got:\n%s", line, zoneFile)
+		}
+	}
+
+	reverseZoneFile, err := zoneFileClient.ExportZoneFile(testZoneFileReverseZone.Ref)
+	if err != nil {
+		t.Fatalf("Error exporting reverse zone file: %s", err)
+	}
+	if !strings.Contains(reverseZoneFile, "20\tIN\tPTR\tapp.zonefile.auslab.cisco.com.\n") {
+		t.Errorf("Expected host record PTR in reverse zone file, got:\n%s", reverseZoneFile)
+	}
+
+	records, err := ParseZoneFile(strings.NewReader(zoneFile), "")
+	if err != nil {
+		t.Fatalf("Error parsing exported zone file: %s", err)
+	}
+	plan, err := zoneFileClient.PlanZoneImport(testZoneFileZone.Ref, records)
+	if err != nil {
+		t.Fatalf("Error planning zone import: %s", err)
+	}
+	if len(plan.Create) != 0 || len(plan.Conflicts) != 0 {
+		t.Errorf("Expected exported records to exist, got %d to create and %d conflicts", len(plan.Create), len(plan.Conflicts))
+	}
+	if len(plan.Existing) != 5 {
+		t.Errorf("Expected 5 existing records, got %d", len(plan.Existing))
+	}
+}
+
+func TestImportZoneFile(t *testing.T) {
+	zoneFile := `$ORIGIN zonefile.auslab.cisco.com.
+www	IN	A	172.19.201.10
+api	IN	A	172.19.201.30
+ftp	IN	CNAME	api
+www	IN	CNAME	api
+_ldap._tcp	IN	SRV	0 100 389 api
+`
+	records, err := ParseZoneFile(strings.NewReader(zoneFile), "")
+	if err != nil {
+		t.Fatalf("Error parsing zone file: %s", err)
+	}
+	plan, err := zoneFileClient.PlanZoneImport(testZoneFileZone.Ref, records)
+	if err != nil {
+		t.Fatalf("Error planning zone import: %s", err)
+	}
+	if len(plan.Existing) != 1 || len(plan.Create) != 2 || len(plan.Conflicts) != 2 {
+		t.Fatalf("Expected 1 existing, 2 new and 2 conflicting records, got %d, %d and %d",
+			len(plan.Existing), len(plan.Create), len(plan.Conflicts))
+	}
+	for _, conflict := range plan.Conflicts {
+		if conflict.Record.Type != "CNAME" {
+			t.Errorf("Expected CNAME conflict, got %s", conflict.Record)
+		}
+	}
+
+	err = zoneFileClient.ApplyZoneImport(plan)
+	if err != nil {
+		t.Fatalf("Error applying zone import: %s", err)
+	}
+	for _, item := range plan.Create {
+		switch object := item.Object.(type) {
+		case *ARecord:
+			testZoneFileRefs = append(testZoneFileRefs, object.Ref)
+		case *SRVRecord:
+			testZoneFileRefs = append(testZoneFileRefs, object.Ref)
+			if object.Target != "api.zonefile.auslab.cisco.com" || object.Port == nil || *object.Port != 389 {
+				t.Errorf("Expected SRV record for api port 389, got %s:%v", object.Target, object.Port)
+			}
+		default:
+			t.Errorf("Unexpected object %T", item.Object)
+		}
+	}
+	aRecords, err := zoneFileClient.GetARecordByQuery(map[string]string{"name": "api.zonefile.auslab.cisco.com"})
+	if err != nil || len(aRecords) != 1 {
+		t.Errorf("Expected imported A record, got %v: %v", aRecords, err)
+	}
+}
+
+func TestDeleteZoneFileRecords(t *testing.T) {
+	for _, ref := range testZoneFileRefs {
+		if strings.HasPrefix(ref, hostRecordBasePath) {
+			if err := zoneFileClient.DeleteHostRecord(ref); err != nil {
+				t.Errorf("Error deleting host record: %s", err)
+			}
+			continue
+		}
+		if err := zoneFileClient.DeleteARecord(ref); err != nil {
+			t.Errorf("Error deleting record %s: %s", ref, err)
+		}
+	}
+	for _, ref := range []string{testZoneFileZone.Ref, testZoneFileReverseZone.Ref} {
+		if err := zoneFileClient.DeleteZoneAuth(ref); err != nil {
+			t.Errorf("Error deleting zone: %s", err)
+		}
+	}
+}
+
+func TestLogoutZoneFile(t *testing.T) {
+	err := zoneFileClient.Logout()
+	if err != nil {
+		t.Errorf("Error logging out: %s", err)
+	}
+}
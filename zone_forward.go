@@ -0,0 +1,127 @@
+package infoblox
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+const (
+	zoneForwardBasePath     = "zone_forward"
+	zoneForwardReturnFields = "fqdn,view,zone_format,comment,disable,locked,ns_group,external_ns_group,display_domain,dns_fqdn,forward_to,forwarders_only,forwarding_servers,extattrs"
+)
+
+// GetZoneForwardByRef gets forward zone by reference
+func (c *Client) GetZoneForwardByRef(ref string, queryParams map[string]string) (ZoneForward, error) {
+	return c.GetZoneForwardByRefWithContext(context.Background(), ref, queryParams)
+}
+
+// GetZoneForwardByRefWithContext gets forward zone by reference using the supplied context
+func (c *Client) GetZoneForwardByRefWithContext(ctx context.Context, ref string, queryParams map[string]string) (ZoneForward, error) {
+	var ret ZoneForward
+	queryParams = returnFields(queryParams, zoneForwardReturnFields)
+
+	queryParamString := c.BuildQuery(queryParams)
+
+	request, err := c.CreateJSONRequestWithContext(ctx, http.MethodGet, fmt.Sprintf("%s?%s", ref, queryParamString), nil)
+	if err != nil {
+		return ret, err
+	}
+
+	response := c.Call(request, &ret)
+	if response != nil {
+		return ret, response
+	}
+
+	return ret, nil
+}
+
+// GetZoneForwardByQuery gets forward zones by query parameters
+func (c *Client) GetZoneForwardByQuery(queryParams map[string]string) ([]ZoneForward, error) {
+	return c.GetZoneForwardByQueryWithContext(context.Background(), queryParams)
+}
+
+// GetZoneForwardByQueryWithContext gets forward zones by query parameters using the supplied context
+func (c *Client) GetZoneForwardByQueryWithContext(ctx context.Context, queryParams map[string]string) ([]ZoneForward, error) {
+	return c.ListZoneForwardsWithContext(ctx, queryParams)
+}
+
+// ListZoneForwards lists all forward zones matching query parameters following every result page
+func (c *Client) ListZoneForwards(queryParams map[string]string) ([]ZoneForward, error) {
+	return c.ListZoneForwardsWithContext(context.Background(), queryParams)
+}
+
+// ListZoneForwardsWithContext lists all forward zones matching query parameters following every result page using the supplied context
+func (c *Client) ListZoneForwardsWithContext(ctx context.Context, queryParams map[string]string) ([]ZoneForward, error) {
+	return listWithReturnFields[ZoneForward](ctx, c, zoneForwardBasePath, zoneForwardReturnFields, queryParams)
+}
+
+// CreateZoneForward creates forward zone
+func (c *Client) CreateZoneForward(zone *ZoneForward) error {
+	return c.CreateZoneForwardWithContext(context.Background(), zone)
+}
+
+// CreateZoneForwardWithContext creates forward zone using the supplied context
+func (c *Client) CreateZoneForwardWithContext(ctx context.Context, zone *ZoneForward) error {
+	zone.View = c.dnsView(zone.View)
+	queryParams := map[string]string{
+		"_return_fields": zoneForwardReturnFields,
+	}
+	queryParamString := c.BuildQuery(queryParams)
+	request, err := c.CreateJSONRequestWithContext(ctx, http.MethodPost, fmt.Sprintf("%s?%s", zoneForwardBasePath, queryParamString), zone)
+	if err != nil {
+		return err
+	}
+
+	response := c.Call(request, &zone)
+	if response != nil {
+		return response
+	}
+	return nil
+}
+
+// UpdateZoneForward updates forward zone
+func (c *Client) UpdateZoneForward(ref string, zone ZoneForward) (ZoneForward, error) {
+	return c.UpdateZoneForwardWithContext(context.Background(), ref, zone)
+}
+
+// UpdateZoneForwardWithContext updates forward zone using the supplied context
+func (c *Client) UpdateZoneForwardWithContext(ctx context.Context, ref string, zone ZoneForward) (ZoneForward, error) {
+	var ret ZoneForward
+	queryParams := map[string]string{
+		"_return_fields": zoneForwardReturnFields,
+	}
+	queryParamString := c.BuildQuery(queryParams)
+	request, err := c.CreateJSONRequestWithContext(ctx, http.MethodPut, fmt.Sprintf("%s?%s", ref, queryParamString), zone)
+	if err != nil {
+		return ret, err
+	}
+
+	response := c.Call(request, &ret)
+	if response != nil {
+		return ret, response
+	}
+	return ret, nil
+}
+
+// DeleteZoneForward deletes forward zone
+func (c *Client) DeleteZoneForward(ref string) error {
+	return c.DeleteZoneForwardWithContext(context.Background(), ref)
+}
+
+// DeleteZoneForwardWithContext deletes forward zone using the supplied context
+func (c *Client) DeleteZoneForwardWithContext(ctx context.Context, ref string) error {
+	request, err := c.CreateJSONRequestWithContext(ctx, http.MethodDelete, fmt.Sprintf("%s", ref), nil)
+	if err != nil {
+		return err
+	}
+
+	response := c.Call(request, nil)
+	if response != nil {
+		if IsNotFound(response) {
+			return nil
+		}
+		return response
+	}
+	return nil
+}
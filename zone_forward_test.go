@@ -0,0 +1,149 @@
+//go:build all || unittests
+// +build all unittests
+
+package infoblox
+
+import (
+	"testing"
+)
+
+var (
+	zoneForwardConfig = testConfig()
+	zoneForwardClient = New(zoneForwardConfig)
+	testZoneForward   = ZoneForward{
+		FQDN: "corp.example.internal",
+		View: "default",
+		ForwardTo: []ExternalServer{
+			{
+				Name:    "dc1.corp.example.internal",
+				Address: "10.100.0.10",
+			},
+		},
+		ForwardersOnly: newBool(true),
+		ExtensibleAttributes: newExtensibleAttribute(ExtensibleAttribute{
+			"Owner": ExtensibleAttributeValue{
+				Value: "testUser",
+			},
+		}),
+	}
+	testZoneStub = ZoneStub{
+		FQDN: "partner.example.internal",
+		View: "default",
+		StubFrom: []ExternalServer{
+			{
+				Name:    "ns1.partner.example.internal",
+				Address: "10.200.0.53",
+			},
+		},
+	}
+	testZoneDelegated = ZoneDelegated{
+		FQDN: "lab.auslab.cisco.com",
+		View: "default",
+		DelegateTo: []ExternalServer{
+			{
+				Name:    "ns1.lab.auslab.cisco.com",
+				Address: "172.19.30.53",
+			},
+		},
+		DelegatedTTL:    newInt(3600),
+		UseDelegatedTTL: newBool(true),
+	}
+)
+
+func TestCreateZoneForward(t *testing.T) {
+	members, err := zoneForwardClient.GetGridMembersByQuery(nil)
+	if err != nil || len(members) == 0 {
+		t.Fatalf("Error retrieving grid members: %v", err)
+	}
+	testZoneForward.ForwardingServers = []ForwardingMemberServer{
+		{
+			Name:           members[0].Hostname,
+			ForwardersOnly: newBool(true),
+		},
+	}
+	err = zoneForwardClient.CreateZoneForward(&testZoneForward)
+	if err != nil {
+		t.Errorf("Error creating forward zone: %s", err)
+	}
+	if len(testZoneForward.ForwardTo) != 1 || testZoneForward.ForwardTo[0].Address != "10.100.0.10" {
+		t.Errorf("Expected forward zone to forward to 10.100.0.10")
+	}
+	if len(testZoneForward.ForwardingServers) != 1 || testZoneForward.ForwardingServers[0].Name != members[0].Hostname {
+		t.Errorf("Expected forwarding member %s", members[0].Hostname)
+	}
+}
+
+func TestUpdateZoneForward(t *testing.T) {
+	updates := ZoneForward{
+		ForwardTo: append(testZoneForward.ForwardTo, ExternalServer{
+			Name:    "dc2.corp.example.internal",
+			Address: "10.100.0.11",
+		}),
+		ExtensibleAttributesAdd: newExtensibleAttribute(ExtensibleAttribute{
+			"Location": ExtensibleAttributeValue{
+				Value: "austin",
+			},
+		}),
+	}
+	zone, err := zoneForwardClient.UpdateZoneForward(testZoneForward.Ref, updates)
+	if err != nil {
+		t.Errorf("Error updating forward zone: %s", err)
+	}
+	if len(zone.ForwardTo) != 2 {
+		t.Errorf("Expected 2 forward to servers, got %d", len(zone.ForwardTo))
+	}
+	eas := *zone.ExtensibleAttributes
+	if eas["Location"].Value.(string) != "austin" || eas["Owner"].Value.(string) != "testUser" {
+		t.Errorf("Error updating forward zone. EA values do not match expected values")
+	}
+	testZoneForward = zone
+}
+
+func TestCreateZoneStub(t *testing.T) {
+	err := zoneForwardClient.CreateZoneStub(&testZoneStub)
+	if err != nil {
+		t.Errorf("Error creating stub zone: %s", err)
+	}
+	duplicate := ZoneStub{
+		FQDN:     testZoneForward.FQDN,
+		View:     "default",
+		StubFrom: testZoneStub.StubFrom,
+	}
+	err = zoneForwardClient.CreateZoneStub(&duplicate)
+	if !IsDuplicate(err) {
+		t.Errorf("Expected duplicate error creating stub zone over forward zone, got %v", err)
+	}
+}
+
+func TestCreateZoneDelegated(t *testing.T) {
+	err := zoneForwardClient.CreateZoneDelegated(&testZoneDelegated)
+	if err != nil {
+		t.Errorf("Error creating delegated zone: %s", err)
+	}
+	zones, err := zoneForwardClient.GetZoneDelegatedByQuery(map[string]string{"fqdn": testZoneDelegated.FQDN})
+	if err != nil {
+		t.Errorf("Error querying delegated zones: %s", err)
+	}
+	if len(zones) != 1 || zones[0].DelegatedTTL == nil || *zones[0].DelegatedTTL != 3600 {
+		t.Errorf("Expected 1 delegated zone with a TTL of 3600")
+	}
+}
+
+func TestDeleteZones(t *testing.T) {
+	if err := zoneForwardClient.DeleteZoneForward(testZoneForward.Ref); err != nil {
+		t.Errorf("Error deleting forward zone: %s", err)
+	}
+	if err := zoneForwardClient.DeleteZoneStub(testZoneStub.Ref); err != nil {
+		t.Errorf("Error deleting stub zone: %s", err)
+	}
+	if err := zoneForwardClient.DeleteZoneDelegated(testZoneDelegated.Ref); err != nil {
+		t.Errorf("Error deleting delegated zone: %s", err)
+	}
+}
+
+func TestLogoutZoneForward(t *testing.T) {
+	err := zoneForwardClient.Logout()
+	if err != nil {
+		t.Errorf("Error logging out: %s", err)
+	}
+}
@@ -0,0 +1,127 @@
+package infoblox
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+const (
+	zoneStubBasePath     = "zone_stub"
+	zoneStubReturnFields = "fqdn,view,zone_format,comment,disable,locked,ns_group,display_domain,dns_fqdn,stub_from,stub_members,extattrs"
+)
+
+// GetZoneStubByRef gets stub zone by reference
+func (c *Client) GetZoneStubByRef(ref string, queryParams map[string]string) (ZoneStub, error) {
+	return c.GetZoneStubByRefWithContext(context.Background(), ref, queryParams)
+}
+
+// GetZoneStubByRefWithContext gets stub zone by reference using the supplied context
+func (c *Client) GetZoneStubByRefWithContext(ctx context.Context, ref string, queryParams map[string]string) (ZoneStub, error) {
+	var ret ZoneStub
+	queryParams = returnFields(queryParams, zoneStubReturnFields)
+
+	queryParamString := c.BuildQuery(queryParams)
+
+	request, err := c.CreateJSONRequestWithContext(ctx, http.MethodGet, fmt.Sprintf("%s?%s", ref, queryParamString), nil)
+	if err != nil {
+		return ret, err
+	}
+
+	response := c.Call(request, &ret)
+	if response != nil {
+		return ret, response
+	}
+
+	return ret, nil
+}
+
+// GetZoneStubByQuery gets stub zones by query parameters
+func (c *Client) GetZoneStubByQuery(queryParams map[string]string) ([]ZoneStub, error) {
+	return c.GetZoneStubByQueryWithContext(context.Background(), queryParams)
+}
+
+// GetZoneStubByQueryWithContext gets stub zones by query parameters using the supplied context
+func (c *Client) GetZoneStubByQueryWithContext(ctx context.Context, queryParams map[string]string) ([]ZoneStub, error) {
+	return c.ListZoneStubsWithContext(ctx, queryParams)
+}
+
+// ListZoneStubs lists all stub zones matching query parameters following every result page
+func (c *Client) ListZoneStubs(queryParams map[string]string) ([]ZoneStub, error) {
+	return c.ListZoneStubsWithContext(context.Background(), queryParams)
+}
+
+// ListZoneStubsWithContext lists all stub zones matching query parameters following every result page using the supplied context
+func (c *Client) ListZoneStubsWithContext(ctx context.Context, queryParams map[string]string) ([]ZoneStub, error) {
+	return listWithReturnFields[ZoneStub](ctx, c, zoneStubBasePath, zoneStubReturnFields, queryParams)
+}
+
+// CreateZoneStub creates stub zone
+func (c *Client) CreateZoneStub(zone *ZoneStub) error {
+	return c.CreateZoneStubWithContext(context.Background(), zone)
+}
+
+// CreateZoneStubWithContext creates stub zone using the supplied context
+func (c *Client) CreateZoneStubWithContext(ctx context.Context, zone *ZoneStub) error {
+	zone.View = c.dnsView(zone.View)
+	queryParams := map[string]string{
+		"_return_fields": zoneStubReturnFields,
+	}
+	queryParamString := c.BuildQuery(queryParams)
+	request, err := c.CreateJSONRequestWithContext(ctx, http.MethodPost, fmt.Sprintf("%s?%s", zoneStubBasePath, queryParamString), zone)
+	if err != nil {
+		return err
+	}
+
+	response := c.Call(request, &zone)
+	if response != nil {
+		return response
+	}
+	return nil
+}
+
+// UpdateZoneStub updates stub zone
+func (c *Client) UpdateZoneStub(ref string, zone ZoneStub) (ZoneStub, error) {
+	return c.UpdateZoneStubWithContext(context.Background(), ref, zone)
+}
+
+// UpdateZoneStubWithContext updates stub zone using the supplied context
+func (c *Client) UpdateZoneStubWithContext(ctx context.Context, ref string, zone ZoneStub) (ZoneStub, error) {
+	var ret ZoneStub
+	queryParams := map[string]string{
+		"_return_fields": zoneStubReturnFields,
+	}
+	queryParamString := c.BuildQuery(queryParams)
+	request, err := c.CreateJSONRequestWithContext(ctx, http.MethodPut, fmt.Sprintf("%s?%s", ref, queryParamString), zone)
+	if err != nil {
+		return ret, err
+	}
+
+	response := c.Call(request, &ret)
+	if response != nil {
+		return ret, response
+	}
+	return ret, nil
+}
+
+// DeleteZoneStub deletes stub zone
+func (c *Client) DeleteZoneStub(ref string) error {
+	return c.DeleteZoneStubWithContext(context.Background(), ref)
+}
+
+// DeleteZoneStubWithContext deletes stub zone using the supplied context
+func (c *Client) DeleteZoneStubWithContext(ctx context.Context, ref string) error {
+	request, err := c.CreateJSONRequestWithContext(ctx, http.MethodDelete, fmt.Sprintf("%s", ref), nil)
+	if err != nil {
+		return err
+	}
+
+	response := c.Call(request, nil)
+	if response != nil {
+		if IsNotFound(response) {
+			return nil
+		}
+		return response
+	}
+	return nil
+}